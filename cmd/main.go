@@ -1,20 +1,101 @@
 package main
 
 import (
-	"fmt"
+	"context"
+	"errors"
+	"log/slog"
 	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"github.com/uandersonricardo/masterclass-go/internal"
+	"github.com/uandersonricardo/masterclass-go/internal/gateway"
+)
+
+const (
+	shutdownTimeout = 30 * time.Second
+	drainDelay      = 5 * time.Second
 )
 
 func main() {
-	fmt.Println("Starting server...")
+	logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
+	config, err := internal.LoadConfigFromEnv()
+
+	if err != nil {
+		logger.Error("invalid configuration", slog.Any("error", err))
+		os.Exit(1)
+	}
+
+	store, err := config.Store()
+
+	if err != nil {
+		logger.Error("failed to open frame store", slog.Any("error", err))
+		os.Exit(1)
+	}
+
+	gatewayCreds, err := config.GatewayCredentials()
+
+	if err != nil {
+		logger.Error("failed to configure gateway", slog.Any("error", err))
+		os.Exit(1)
+	}
+
+	gw, err := gateway.NewGateway(config.GrpcTarget(), config.GatewayAddress, gatewayCreds)
+
+	if err != nil {
+		logger.Error("failed to configure gateway", slog.Any("error", err))
+		os.Exit(1)
+	}
+
+	logger.Info("starting server", slog.String("address", config.GrpcAddress))
+
+	opts := append(config.Options(), internal.WithLogger(logger))
+	server := internal.NewGrpcServer(config.GrpcAddress, store, opts...)
+	errCh := make(chan error, 2)
+
+	go func() {
+		errCh <- server.Start()
+	}()
+
+	go func() {
+		errCh <- gw.Start()
+	}()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	select {
+	case err := <-errCh:
+		if errors.Is(err, internal.ErrAddressInUse) {
+			logger.Error("address already in use, set GRPC_ADDRESS, GATEWAY_ADDRESS or METRICS_ADDRESS to another port", slog.Any("error", err))
+			os.Exit(1)
+		}
+
+		if err != nil {
+			logger.Error("failed to start server", slog.Any("error", err))
+			os.Exit(1)
+		}
+
+		return
+	case <-ctx.Done():
+	}
+
+	logger.Info("shutting down")
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	// Stop the gateway first so it does not keep proxying into the draining
+	// server.
+	if err := gw.Stop(shutdownCtx); err != nil {
+		logger.Error("failed to stop gateway", slog.Any("error", err))
+	}
 
-	server := internal.NewGrpcServer(":8080")
-	err := server.Start()
+	err = server.Shutdown(shutdownCtx, drainDelay)
 
 	if err != nil {
-		fmt.Printf("Error starting server: %v\n", err)
+		logger.Error("failed to stop server", slog.Any("error", err))
 		os.Exit(1)
 	}
 }
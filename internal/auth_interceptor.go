@@ -0,0 +1,76 @@
+package internal
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const bearerPrefix = "bearer "
+
+type TokenValidator func(token string) error
+
+// AuthUnaryInterceptor requires a valid bearer token on every call except
+// the given public methods, such as healthpb.Health_Check_FullMethodName.
+func AuthUnaryInterceptor(validate TokenValidator, publicMethods ...string) grpc.UnaryServerInterceptor {
+	public := methodSet(publicMethods)
+
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if !public[info.FullMethod] {
+			if err := authenticate(ctx, validate); err != nil {
+				return nil, err
+			}
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+func AuthStreamInterceptor(validate TokenValidator, publicMethods ...string) grpc.StreamServerInterceptor {
+	public := methodSet(publicMethods)
+
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !public[info.FullMethod] {
+			if err := authenticate(ss.Context(), validate); err != nil {
+				return err
+			}
+		}
+
+		return handler(srv, ss)
+	}
+}
+
+func authenticate(ctx context.Context, validate TokenValidator) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get("authorization")
+
+	if len(values) == 0 {
+		return status.Error(codes.Unauthenticated, "missing authorization metadata")
+	}
+
+	header := values[0]
+
+	if len(header) <= len(bearerPrefix) || !strings.EqualFold(header[:len(bearerPrefix)], bearerPrefix) {
+		return status.Error(codes.Unauthenticated, "authorization is not a bearer token")
+	}
+
+	if err := validate(header[len(bearerPrefix):]); err != nil {
+		return status.Errorf(codes.Unauthenticated, "invalid token: %v", err)
+	}
+
+	return nil
+}
+
+func methodSet(methods []string) map[string]bool {
+	set := make(map[string]bool, len(methods))
+
+	for _, method := range methods {
+		set[method] = true
+	}
+
+	return set
+}
@@ -0,0 +1,105 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const healthCheckMethod = "/grpc.health.v1.Health/Check"
+
+// contextStream is a server stream that only carries a context.
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s contextStream) Context() context.Context {
+	return s.ctx
+}
+
+func validateSecret(token string) error {
+	if token != "secret" {
+		return errors.New("unknown token")
+	}
+
+	return nil
+}
+
+var authTests = []struct {
+	name   string
+	header string
+	method string
+	want   codes.Code
+}{
+	{name: "missing header", method: getFrameMethod, want: codes.Unauthenticated},
+	{name: "basic scheme", header: "Basic dXNlcjpwYXNz", method: getFrameMethod, want: codes.Unauthenticated},
+	{name: "bare prefix", header: "Bearer ", method: getFrameMethod, want: codes.Unauthenticated},
+	{name: "invalid token", header: "Bearer wrong", method: getFrameMethod, want: codes.Unauthenticated},
+	{name: "valid token", header: "Bearer secret", method: getFrameMethod, want: codes.OK},
+	{name: "lowercase scheme", header: "bearer secret", method: getFrameMethod, want: codes.OK},
+	{name: "uppercase scheme", header: "BEARER secret", method: getFrameMethod, want: codes.OK},
+	{name: "public method", method: healthCheckMethod, want: codes.OK},
+}
+
+func authContext(header string) context.Context {
+	if header == "" {
+		return context.Background()
+	}
+
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", header))
+}
+
+func TestAuthUnaryInterceptor(t *testing.T) {
+	interceptor := AuthUnaryInterceptor(validateSecret, healthCheckMethod)
+
+	for _, tt := range authTests {
+		t.Run(tt.name, func(t *testing.T) {
+			called := false
+			handler := func(ctx context.Context, req any) (any, error) {
+				called = true
+				return nil, nil
+			}
+
+			_, err := interceptor(authContext(tt.header), nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+
+			if code := status.Code(err); code != tt.want {
+				t.Errorf("code = %v (%v), want %v", code, err, tt.want)
+			}
+
+			if called != (tt.want == codes.OK) {
+				t.Errorf("handler called = %v, want %v", called, tt.want == codes.OK)
+			}
+		})
+	}
+}
+
+func TestAuthStreamInterceptor(t *testing.T) {
+	interceptor := AuthStreamInterceptor(validateSecret, healthCheckMethod)
+
+	for _, tt := range authTests {
+		t.Run(tt.name, func(t *testing.T) {
+			called := false
+			handler := func(srv any, ss grpc.ServerStream) error {
+				called = true
+				return nil
+			}
+
+			ss := contextStream{ctx: authContext(tt.header)}
+			err := interceptor(nil, ss, &grpc.StreamServerInfo{FullMethod: tt.method}, handler)
+
+			if code := status.Code(err); code != tt.want {
+				t.Errorf("code = %v (%v), want %v", code, err, tt.want)
+			}
+
+			if called != (tt.want == codes.OK) {
+				t.Errorf("handler called = %v, want %v", called, tt.want == codes.OK)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package internal
+
+import (
+	"context"
+	"sync"
+
+	"github.com/uandersonricardo/masterclass-go/pkg/pb"
+)
+
+const (
+	maxBatchSize  = 100
+	maxRangeCount = 100
+)
+
+type batchResult struct {
+	frame *pb.Frame
+	err   error
+}
+
+// batchGet looks up every id using at most concurrency workers. Results are
+// returned in the same order as ids.
+func batchGet(ctx context.Context, store FrameStore, ids []int32, concurrency int) []batchResult {
+	results := make([]batchResult, len(ids))
+	jobs := make(chan int)
+	wg := &sync.WaitGroup{}
+
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+
+	for w := 0; w < concurrency && w < len(ids); w++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for i := range jobs {
+				frame, err := store.Get(ctx, ids[i])
+				results[i] = batchResult{frame: frame, err: err}
+			}
+		}()
+	}
+
+	for i := range ids {
+		jobs <- i
+	}
+
+	close(jobs)
+	wg.Wait()
+
+	return results
+}
@@ -0,0 +1,219 @@
+package internal
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uandersonricardo/masterclass-go/pkg/pb"
+	"google.golang.org/protobuf/proto"
+)
+
+const defaultCacheMaxEntries = 1024
+
+type CachingFrameStoreOption func(*CachingFrameStore)
+
+// CachingFrameStore serves Get from a bounded LRU cache in front of another
+// FrameStore. Put and Delete go straight to the underlying store and evict
+// the cached copy. Frames from a MemoryFrameStore with a TTL are dropped from
+// the cache when they expire.
+type CachingFrameStore struct {
+	FrameStore
+
+	maxEntries int
+	maxBytes   int
+
+	mu      sync.Mutex
+	lru     *list.List
+	entries map[int32]*list.Element
+	loads   map[int32]*cacheLoad
+	bytes   int
+
+	hits   atomic.Uint64
+	misses atomic.Uint64
+}
+
+type cacheEntry struct {
+	frame     *pb.Frame
+	size      int
+	expiresAt time.Time
+}
+
+// expiringFrameStore is implemented by stores whose frames expire, such as a
+// MemoryFrameStore with a TTL, so the cache can drop frames at the same time.
+// Wrappers that override Get should embed the FrameStore interface rather
+// than a *MemoryFrameStore, or the cache bypasses their Get.
+type expiringFrameStore interface {
+	getWithExpiry(ctx context.Context, id int32) (*pb.Frame, time.Time, error)
+}
+
+// cacheLoad is shared by concurrent misses for the same id. A write while the
+// load is in flight marks it stale so its possibly outdated result is not
+// cached.
+type cacheLoad struct {
+	refs  int
+	stale bool
+}
+
+// WithCacheMaxEntries bounds the number of cached frames, 1024 by default.
+func WithCacheMaxEntries(n int) CachingFrameStoreOption {
+	return func(c *CachingFrameStore) {
+		c.maxEntries = n
+	}
+}
+
+// WithCacheMaxBytes bounds the encoded size of all cached frames. There is no
+// limit by default.
+func WithCacheMaxBytes(n int) CachingFrameStoreOption {
+	return func(c *CachingFrameStore) {
+		c.maxBytes = n
+	}
+}
+
+func NewCachingFrameStore(store FrameStore, opts ...CachingFrameStoreOption) *CachingFrameStore {
+	c := &CachingFrameStore{
+		FrameStore: store,
+		maxEntries: defaultCacheMaxEntries,
+		lru:        list.New(),
+		entries:    make(map[int32]*list.Element),
+		loads:      make(map[int32]*cacheLoad),
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+func (c *CachingFrameStore) Get(ctx context.Context, id int32) (*pb.Frame, error) {
+	c.mu.Lock()
+
+	if el, ok := c.entries[id]; ok {
+		entry := el.Value.(*cacheEntry)
+
+		if entry.expiresAt.IsZero() || time.Now().Before(entry.expiresAt) {
+			c.lru.MoveToFront(el)
+			c.mu.Unlock()
+
+			c.hits.Add(1)
+			return proto.Clone(entry.frame).(*pb.Frame), nil
+		}
+
+		c.remove(el)
+	}
+
+	load, ok := c.loads[id]
+
+	if !ok {
+		load = &cacheLoad{}
+		c.loads[id] = load
+	}
+
+	load.refs++
+	c.mu.Unlock()
+
+	c.misses.Add(1)
+	frame, expiresAt, err := c.load(ctx, id)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	load.refs--
+
+	if load.refs == 0 && c.loads[id] == load {
+		delete(c.loads, id)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !load.stale {
+		c.add(proto.Clone(frame).(*pb.Frame), expiresAt)
+	}
+
+	return frame, nil
+}
+
+func (c *CachingFrameStore) load(ctx context.Context, id int32) (*pb.Frame, time.Time, error) {
+	if store, ok := c.FrameStore.(expiringFrameStore); ok {
+		return store.getWithExpiry(ctx, id)
+	}
+
+	frame, err := c.FrameStore.Get(ctx, id)
+	return frame, time.Time{}, err
+}
+
+func (c *CachingFrameStore) Put(ctx context.Context, frame *pb.Frame) error {
+	defer c.invalidate(frame.Id)
+	return c.FrameStore.Put(ctx, frame)
+}
+
+func (c *CachingFrameStore) PutIfAbsent(ctx context.Context, frame *pb.Frame) error {
+	defer c.invalidate(frame.Id)
+	return c.FrameStore.PutIfAbsent(ctx, frame)
+}
+
+func (c *CachingFrameStore) Delete(ctx context.Context, id int32) error {
+	defer c.invalidate(id)
+	return c.FrameStore.Delete(ctx, id)
+}
+
+// Stats returns the number of cache hits and misses so far.
+func (c *CachingFrameStore) Stats() (hits, misses uint64) {
+	return c.hits.Load(), c.misses.Load()
+}
+
+func (c *CachingFrameStore) invalidate(id int32) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if load, ok := c.loads[id]; ok {
+		load.stale = true
+		delete(c.loads, id)
+	}
+
+	if el, ok := c.entries[id]; ok {
+		c.remove(el)
+	}
+}
+
+func (c *CachingFrameStore) add(frame *pb.Frame, expiresAt time.Time) {
+	if el, ok := c.entries[frame.Id]; ok {
+		c.remove(el)
+	}
+
+	entry := &cacheEntry{
+		frame:     frame,
+		size:      proto.Size(frame),
+		expiresAt: expiresAt,
+	}
+
+	if c.maxBytes > 0 && entry.size > c.maxBytes {
+		return
+	}
+
+	c.entries[frame.Id] = c.lru.PushFront(entry)
+	c.bytes += entry.size
+
+	for c.overCapacity() {
+		c.remove(c.lru.Back())
+	}
+}
+
+func (c *CachingFrameStore) overCapacity() bool {
+	if c.maxEntries > 0 && c.lru.Len() > c.maxEntries {
+		return true
+	}
+
+	return c.maxBytes > 0 && c.bytes > c.maxBytes
+}
+
+func (c *CachingFrameStore) remove(el *list.Element) {
+	entry := c.lru.Remove(el).(*cacheEntry)
+	delete(c.entries, entry.frame.Id)
+	c.bytes -= entry.size
+}
@@ -0,0 +1,129 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/uandersonricardo/masterclass-go/pkg/pb"
+)
+
+func TestCachingFrameStoreStats(t *testing.T) {
+	ctx := context.Background()
+	store := NewCachingFrameStore(NewMemoryFrameStore())
+	store.Put(ctx, &pb.Frame{Id: 1, Data: []byte("frame")})
+
+	const readers, reads = 10, 100
+
+	var wg sync.WaitGroup
+
+	// Warm the cache so every concurrent read below is a hit.
+	store.Get(ctx, 1)
+
+	for i := 0; i < readers; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for j := 0; j < reads; j++ {
+				if _, err := store.Get(ctx, 1); err != nil {
+					t.Errorf("Get() = %v", err)
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if hits, misses := store.Stats(); hits != readers*reads || misses != 1 {
+		t.Errorf("Stats() = %d hits, %d misses, want %d hits, 1 miss", hits, misses, readers*reads)
+	}
+}
+
+func TestCachingFrameStoreEviction(t *testing.T) {
+	ctx := context.Background()
+	store := NewCachingFrameStore(NewMemoryFrameStore(), WithCacheMaxEntries(2))
+
+	for id := int32(1); id <= 3; id++ {
+		store.Put(ctx, &pb.Frame{Id: id})
+		store.Get(ctx, id)
+	}
+
+	// Frame 1 was the least recently used and has been evicted.
+	store.Get(ctx, 3)
+	store.Get(ctx, 1)
+
+	if hits, misses := store.Stats(); hits != 1 || misses != 4 {
+		t.Errorf("Stats() = %d hits, %d misses, want 1 hit, 4 misses", hits, misses)
+	}
+}
+
+// gatedStore returns the frame stored when Get was called, but only once
+// release is closed.
+type gatedStore struct {
+	FrameStore
+	loading chan struct{}
+	release chan struct{}
+}
+
+func (g *gatedStore) Get(ctx context.Context, id int32) (*pb.Frame, error) {
+	frame, err := g.FrameStore.Get(ctx, id)
+	g.loading <- struct{}{}
+	<-g.release
+
+	return frame, err
+}
+
+func TestCachingFrameStoreInvalidateDuringLoad(t *testing.T) {
+	ctx := context.Background()
+	backing := &gatedStore{
+		FrameStore: NewMemoryFrameStore(),
+		loading:    make(chan struct{}, 2),
+		release:    make(chan struct{}),
+	}
+	backing.Put(ctx, &pb.Frame{Id: 1, Data: []byte("old")})
+	store := NewCachingFrameStore(backing)
+
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		if frame, err := store.Get(ctx, 1); err != nil || string(frame.Data) != "old" {
+			t.Errorf("in-flight Get() = %v, %v, want the old frame", frame, err)
+		}
+	}()
+
+	<-backing.loading
+	store.Put(ctx, &pb.Frame{Id: 1, Data: []byte("new")})
+	close(backing.release)
+	<-done
+
+	frame, err := store.Get(ctx, 1)
+
+	if err != nil || string(frame.Data) != "new" {
+		t.Errorf("Get() after Put = %v, %v, want the new frame", frame, err)
+	}
+}
+
+func TestCachingFrameStoreExpiry(t *testing.T) {
+	ctx := context.Background()
+	backing := NewMemoryFrameStore(WithTTL(50 * time.Millisecond))
+	defer backing.Close()
+
+	store := NewCachingFrameStore(backing)
+	store.Put(ctx, &pb.Frame{Id: 1})
+
+	if _, err := store.Get(ctx, 1); err != nil {
+		t.Fatalf("Get() = %v", err)
+	}
+
+	time.Sleep(120 * time.Millisecond)
+
+	if _, err := store.Get(ctx, 1); !errors.Is(err, ErrFrameNotFound) {
+		t.Errorf("Get() after the TTL = %v, want %v", err, ErrFrameNotFound)
+	}
+}
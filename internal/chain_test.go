@@ -0,0 +1,78 @@
+package internal
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"google.golang.org/grpc"
+)
+
+func TestChainUnaryInterceptorsOrder(t *testing.T) {
+	var calls []string
+
+	record := func(name string) grpc.UnaryServerInterceptor {
+		return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+			calls = append(calls, name+" before")
+			res, err := handler(ctx, req)
+			calls = append(calls, name+" after")
+
+			return res, err
+		}
+	}
+
+	chain := ChainUnaryInterceptors(record("a"), record("b"), record("c"))
+	handler := func(ctx context.Context, req any) (any, error) {
+		calls = append(calls, "handler")
+		return nil, nil
+	}
+
+	chain(context.Background(), nil, &grpc.UnaryServerInfo{}, handler)
+
+	want := []string{"a before", "b before", "c before", "handler", "c after", "b after", "a after"}
+
+	if !slices.Equal(calls, want) {
+		t.Errorf("calls = %v, want %v", calls, want)
+	}
+}
+
+func TestChainStreamInterceptorsOrder(t *testing.T) {
+	var calls []string
+
+	record := func(name string) grpc.StreamServerInterceptor {
+		return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			calls = append(calls, name+" before")
+			err := handler(srv, ss)
+			calls = append(calls, name+" after")
+
+			return err
+		}
+	}
+
+	chain := ChainStreamInterceptors(record("a"), record("b"), record("c"))
+	handler := func(srv any, ss grpc.ServerStream) error {
+		calls = append(calls, "handler")
+		return nil
+	}
+
+	chain(nil, nil, &grpc.StreamServerInfo{}, handler)
+
+	want := []string{"a before", "b before", "c before", "handler", "c after", "b after", "a after"}
+
+	if !slices.Equal(calls, want) {
+		t.Errorf("calls = %v, want %v", calls, want)
+	}
+}
+
+func TestChainUnaryInterceptorsEmpty(t *testing.T) {
+	called := false
+
+	ChainUnaryInterceptors()(context.Background(), nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, req any) (any, error) {
+		called = true
+		return nil, nil
+	})
+
+	if !called {
+		t.Error("handler not called by an empty chain")
+	}
+}
@@ -0,0 +1,98 @@
+package internal
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/uandersonricardo/masterclass-go/pkg/pb"
+	"google.golang.org/protobuf/proto"
+)
+
+var ErrChecksumMismatch = errors.New("checksum mismatch")
+
+func frameChecksum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func verifyChecksum(frame *pb.Frame) error {
+	if frame.Checksum == "" || frame.Checksum == frameChecksum(frame.Data) {
+		return nil
+	}
+
+	return fmt.Errorf("frame %d: %w", frame.Id, ErrChecksumMismatch)
+}
+
+// checksumFrameStore fills in missing checksums when frames are stored and
+// verifies them when frames are read back.
+type checksumFrameStore struct {
+	FrameStore
+}
+
+func (c checksumFrameStore) Get(ctx context.Context, id int32) (*pb.Frame, error) {
+	frame, err := c.FrameStore.Get(ctx, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := verifyChecksum(frame); err != nil {
+		return nil, err
+	}
+
+	return frame, nil
+}
+
+func (c checksumFrameStore) List(ctx context.Context, afterID int32, limit int) ([]*pb.Frame, error) {
+	frames, err := c.FrameStore.List(ctx, afterID, limit)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, frame := range frames {
+		if err := verifyChecksum(frame); err != nil {
+			return nil, err
+		}
+	}
+
+	return frames, nil
+}
+
+func (c checksumFrameStore) Put(ctx context.Context, frame *pb.Frame) error {
+	frame, err := withChecksum(frame)
+
+	if err != nil {
+		return err
+	}
+
+	return c.FrameStore.Put(ctx, frame)
+}
+
+func (c checksumFrameStore) PutIfAbsent(ctx context.Context, frame *pb.Frame) error {
+	frame, err := withChecksum(frame)
+
+	if err != nil {
+		return err
+	}
+
+	return c.FrameStore.PutIfAbsent(ctx, frame)
+}
+
+// withChecksum verifies the checksum of frame, or returns a copy with one
+// filled in when it has none.
+func withChecksum(frame *pb.Frame) (*pb.Frame, error) {
+	if err := verifyChecksum(frame); err != nil {
+		return nil, err
+	}
+
+	if frame.Checksum == "" {
+		frame = proto.Clone(frame).(*pb.Frame)
+		frame.Checksum = frameChecksum(frame.Data)
+	}
+
+	return frame, nil
+}
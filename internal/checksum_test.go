@@ -0,0 +1,129 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/uandersonricardo/masterclass-go/pkg/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// newCorruptedClient serves a store holding frame 1 whose data no longer
+// matches its checksum.
+func newCorruptedClient(t *testing.T) pb.FrameServiceClient {
+	t.Helper()
+
+	store := NewMemoryFrameStore()
+	store.Put(context.Background(), &pb.Frame{
+		Id:       1,
+		Data:     testPNG(t, 4, 4),
+		Encoding: "png",
+		Checksum: frameChecksum([]byte("original data")),
+	})
+
+	s := NewGrpcServer("127.0.0.1:0", store)
+	return pb.NewFrameServiceClient(startTestServer(t, s))
+}
+
+func TestChecksumMismatchIsDataLoss(t *testing.T) {
+	client := newCorruptedClient(t)
+	ctx := context.Background()
+
+	calls := map[string]func() error{
+		"GetFrame": func() error {
+			_, err := client.GetFrame(ctx, &pb.GetFrameRequest{Id: 1})
+			return err
+		},
+		"BatchGetFrames": func() error {
+			_, err := client.BatchGetFrames(ctx, &pb.BatchGetFramesRequest{Ids: []int32{1}})
+			return err
+		},
+		"StreamFrames": func() error {
+			stream, err := client.StreamFrames(ctx, &pb.StreamFramesRequest{StartId: 1, EndId: 1})
+
+			if err != nil {
+				return err
+			}
+
+			_, err = stream.Recv()
+			return err
+		},
+		"WatchFrames": func() error {
+			stream, err := client.WatchFrames(ctx)
+
+			if err != nil {
+				return err
+			}
+
+			if err := stream.Send(&pb.WatchRequest{Command: pb.WatchRequest_SEEK, SeekId: 1}); err != nil {
+				return err
+			}
+
+			_, err = stream.Recv()
+			return err
+		},
+	}
+
+	for name, call := range calls {
+		t.Run(name, func(t *testing.T) {
+			if code := status.Code(call()); code != codes.DataLoss {
+				t.Errorf("%s code = %v, want %v", name, code, codes.DataLoss)
+			}
+		})
+	}
+}
+
+func TestChecksumFrameStoreList(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryFrameStore()
+	store.Put(ctx, &pb.Frame{Id: 1, Data: []byte("frame"), Checksum: frameChecksum([]byte("other"))})
+
+	_, err := checksumFrameStore{store}.List(ctx, 0, 10)
+
+	if !errors.Is(err, ErrChecksumMismatch) {
+		t.Errorf("List() = %v, want %v", err, ErrChecksumMismatch)
+	}
+}
+
+func TestPublishedFramesHaveChecksum(t *testing.T) {
+	s := NewGrpcServer("127.0.0.1:0", NewMemoryFrameStore())
+	client := pb.NewFrameServiceClient(startTestServer(t, s))
+	ctx := context.Background()
+
+	frames := s.broker.subscribe()
+	defer s.broker.unsubscribe(frames)
+
+	data := testPNG(t, 4, 4)
+
+	if _, err := client.PutFrame(ctx, &pb.PutFrameRequest{Frame: &pb.Frame{Id: 1, Data: data}}); err != nil {
+		t.Fatalf("PutFrame() = %v", err)
+	}
+
+	upload, err := client.UploadFrames(ctx)
+
+	if err != nil {
+		t.Fatalf("UploadFrames() = %v", err)
+	}
+
+	if err := upload.Send(&pb.Frame{Id: 2, Data: data}); err != nil {
+		t.Fatalf("UploadFrames() Send = %v", err)
+	}
+
+	if _, err := upload.CloseAndRecv(); err != nil {
+		t.Fatalf("UploadFrames() CloseAndRecv = %v", err)
+	}
+
+	for _, id := range []int32{1, 2} {
+		select {
+		case frame := <-frames:
+			if frame.Id != id || frame.Checksum != frameChecksum(data) {
+				t.Errorf("published frame %d checksum = %q, want frame %d with %q", frame.Id, frame.Checksum, id, frameChecksum(data))
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("frame %d was not published", id)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/encoding"
+	_ "google.golang.org/grpc/encoding/gzip"
+)
+
+func validateCompressor(name string) error {
+	if encoding.GetCompressor(name) == nil {
+		return fmt.Errorf("unknown compressor %q", name)
+	}
+
+	return nil
+}
+
+// setSendCompressor compresses the response with name only when the client
+// advertised support for it, so clients without it keep working.
+func setSendCompressor(ctx context.Context, name string) {
+	supported, err := grpc.ClientSupportedCompressors(ctx)
+
+	if err != nil || !slices.Contains(supported, name) {
+		return
+	}
+
+	grpc.SetSendCompressor(ctx, name)
+}
+
+func compressionUnaryInterceptor(name string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		setSendCompressor(ctx, name)
+		return handler(ctx, req)
+	}
+}
+
+func compressionStreamInterceptor(name string) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		setSendCompressor(ss.Context(), name)
+		return handler(srv, ss)
+	}
+}
@@ -0,0 +1,153 @@
+package internal
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/credentials"
+)
+
+type Config struct {
+	GrpcAddress    string
+	GatewayAddress string
+	MetricsAddress string
+	TLSCert        string
+	TLSKey         string
+	// GatewayTLSServerName is the name the gateway expects in the server
+	// certificate. It defaults to the host of GrpcTarget, usually localhost.
+	GatewayTLSServerName string
+	MaxRecvMB            int
+	Reflection           bool
+	StoreDir             string
+}
+
+// LoadConfigFromEnv reads the server configuration from the environment.
+// Unset variables fall back to the defaults below.
+func LoadConfigFromEnv() (Config, error) {
+	config := Config{
+		GrpcAddress:          getEnv("GRPC_ADDRESS", ":8080"),
+		GatewayAddress:       getEnv("GATEWAY_ADDRESS", ":8081"),
+		MetricsAddress:       getEnv("METRICS_ADDRESS", ":9090"),
+		TLSCert:              os.Getenv("GRPC_TLS_CERT"),
+		TLSKey:               os.Getenv("GRPC_TLS_KEY"),
+		GatewayTLSServerName: os.Getenv("GATEWAY_TLS_SERVER_NAME"),
+		StoreDir:             os.Getenv("FRAME_STORE_DIR"),
+		MaxRecvMB:            defaultMaxRecvMsgSize / (1024 * 1024),
+	}
+
+	if config.GrpcAddress == "" {
+		return Config{}, fmt.Errorf("GRPC_ADDRESS must not be empty")
+	}
+
+	if (config.TLSCert == "") != (config.TLSKey == "") {
+		return Config{}, fmt.Errorf("GRPC_TLS_CERT and GRPC_TLS_KEY must be set together")
+	}
+
+	if value, ok := os.LookupEnv("GRPC_MAX_RECV_MB"); ok {
+		size, err := strconv.Atoi(value)
+
+		if err != nil {
+			return Config{}, fmt.Errorf("GRPC_MAX_RECV_MB: %w", err)
+		}
+
+		if size <= 0 {
+			return Config{}, fmt.Errorf("GRPC_MAX_RECV_MB must be positive, got %d", size)
+		}
+
+		config.MaxRecvMB = size
+	}
+
+	if value, ok := os.LookupEnv("GRPC_REFLECTION"); ok {
+		reflection, err := strconv.ParseBool(value)
+
+		if err != nil {
+			return Config{}, fmt.Errorf("GRPC_REFLECTION: %w", err)
+		}
+
+		config.Reflection = reflection
+	}
+
+	return config, nil
+}
+
+func (c Config) Options() []Option {
+	opts := []Option{
+		WithMaxRecvMsgSize(c.MaxRecvMB * 1024 * 1024),
+		WithMetrics(c.MetricsAddress),
+	}
+
+	if c.TLSCert != "" {
+		opts = append(opts, WithTLS(c.TLSCert, c.TLSKey))
+	}
+
+	if c.Reflection {
+		opts = append(opts, WithReflection())
+	}
+
+	return opts
+}
+
+// Store keeps frames on disk under StoreDir when it is set, and in memory
+// otherwise.
+func (c Config) Store() (FrameStore, error) {
+	if c.StoreDir == "" {
+		return NewMemoryFrameStore(), nil
+	}
+
+	return NewFileFrameStore(c.StoreDir)
+}
+
+// GrpcTarget is the address local clients such as the gateway dial to reach
+// the gRPC server.
+func (c Config) GrpcTarget() string {
+	if strings.HasPrefix(c.GrpcAddress, ":") {
+		return "localhost" + c.GrpcAddress
+	}
+
+	return c.GrpcAddress
+}
+
+// GatewayCredentials returns the credentials the gateway dials the gRPC
+// server with, or nil when the server does not use TLS. The server
+// certificate is trusted in addition to the system roots, so self-signed
+// certificates work. Certificates without a localhost name need
+// GatewayTLSServerName set to one of their names.
+func (c Config) GatewayCredentials() (credentials.TransportCredentials, error) {
+	if c.TLSCert == "" {
+		return nil, nil
+	}
+
+	pool, err := x509.SystemCertPool()
+
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+
+	pem, err := os.ReadFile(c.TLSCert)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to read certificate: %w", err)
+	}
+
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no certificates found in %s", c.TLSCert)
+	}
+
+	return credentials.NewTLS(&tls.Config{
+		MinVersion: tls.VersionTLS12,
+		RootCAs:    pool,
+		ServerName: c.GatewayTLSServerName,
+	}), nil
+}
+
+func getEnv(key, fallback string) string {
+	if value, ok := os.LookupEnv(key); ok {
+		return value
+	}
+
+	return fallback
+}
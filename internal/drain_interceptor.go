@@ -0,0 +1,42 @@
+package internal
+
+import (
+	"context"
+	"sync/atomic"
+
+	"google.golang.org/grpc"
+)
+
+// drainGate rejects new calls while draining. Health checks are always let
+// through so probes keep seeing the reported serving status.
+type drainGate struct {
+	draining atomic.Bool
+}
+
+func (g *drainGate) admit(method string) error {
+	if !g.draining.Load() || isHealthMethod(method) {
+		return nil
+	}
+
+	return newDrainingStatus()
+}
+
+func (g *drainGate) unaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := g.admit(info.FullMethod); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+func (g *drainGate) streamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := g.admit(info.FullMethod); err != nil {
+			return err
+		}
+
+		return handler(srv, ss)
+	}
+}
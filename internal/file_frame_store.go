@@ -0,0 +1,305 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/uandersonricardo/masterclass-go/pkg/pb"
+)
+
+const frameFileExt = ".frame"
+
+// FileFrameStore keeps each frame in its own file under dir. A file holds a
+// 4-byte big-endian header length, the JSON encoded header and then the raw
+// frame data.
+type FileFrameStore struct {
+	dir string
+	mu  sync.RWMutex
+}
+
+type fileFrameHeader struct {
+	TimestampMs int64  `json:"timestamp_ms"`
+	Width       uint32 `json:"width"`
+	Height      uint32 `json:"height"`
+	Encoding    string `json:"encoding"`
+	Checksum    string `json:"checksum"`
+}
+
+func NewFileFrameStore(dir string) (*FileFrameStore, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+
+	return &FileFrameStore{
+		dir: dir,
+	}, nil
+}
+
+func (f *FileFrameStore) Get(ctx context.Context, id int32) (*pb.Frame, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	path, err := f.path(id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	f.mu.RLock()
+	content, err := os.ReadFile(path)
+	f.mu.RUnlock()
+
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrFrameNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeFrameFile(id, content)
+}
+
+func (f *FileFrameStore) Put(ctx context.Context, frame *pb.Frame) error {
+	return f.put(frame, true)
+}
+
+func (f *FileFrameStore) PutIfAbsent(ctx context.Context, frame *pb.Frame) error {
+	return f.put(frame, false)
+}
+
+func (f *FileFrameStore) put(frame *pb.Frame, overwrite bool) error {
+	path, err := f.path(frame.Id)
+
+	if err != nil {
+		return err
+	}
+
+	content, err := encodeFrameFile(frame)
+
+	if err != nil {
+		return err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if !overwrite {
+		_, err := os.Lstat(path)
+
+		if err == nil {
+			return ErrFrameExists
+		}
+
+		if !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+	}
+
+	tmp := path + ".tmp"
+
+	if err := os.WriteFile(tmp, content, 0644); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, path)
+}
+
+func (f *FileFrameStore) Delete(ctx context.Context, id int32) error {
+	path, err := f.path(id)
+
+	if err != nil {
+		return err
+	}
+
+	f.mu.Lock()
+	err = os.Remove(path)
+	f.mu.Unlock()
+
+	if errors.Is(err, fs.ErrNotExist) {
+		return ErrFrameNotFound
+	}
+
+	return err
+}
+
+func (f *FileFrameStore) List(ctx context.Context, afterID int32, limit int) ([]*pb.Frame, error) {
+	return f.list(ctx, afterID, limit, f.Get)
+}
+
+// ListHeaders only reads the header at the start of each file.
+func (f *FileFrameStore) ListHeaders(ctx context.Context, afterID int32, limit int) ([]*pb.Frame, error) {
+	return f.list(ctx, afterID, limit, f.getHeader)
+}
+
+func (f *FileFrameStore) list(ctx context.Context, afterID int32, limit int, load func(context.Context, int32) (*pb.Frame, error)) ([]*pb.Frame, error) {
+	f.mu.RLock()
+	entries, err := os.ReadDir(f.dir)
+	f.mu.RUnlock()
+
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []int32
+
+	for _, entry := range entries {
+		name, ok := strings.CutSuffix(entry.Name(), frameFileExt)
+
+		if !ok {
+			continue
+		}
+
+		id, err := strconv.ParseInt(name, 10, 32)
+
+		if err == nil && int32(id) > afterID {
+			ids = append(ids, int32(id))
+		}
+	}
+
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	frames := make([]*pb.Frame, 0, min(len(ids), limit))
+
+	for _, id := range ids {
+		if len(frames) == limit {
+			break
+		}
+
+		frame, err := load(ctx, id)
+
+		// Skip frames deleted since the directory was read.
+		if errors.Is(err, ErrFrameNotFound) {
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		frames = append(frames, frame)
+	}
+
+	return frames, nil
+}
+
+func (f *FileFrameStore) getHeader(ctx context.Context, id int32) (*pb.Frame, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	path, err := f.path(id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	file, err := os.Open(path)
+
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrFrameNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer file.Close()
+
+	var size uint32
+
+	if err := binary.Read(file, binary.BigEndian, &size); err != nil {
+		return nil, fmt.Errorf("frame %d: truncated file", id)
+	}
+
+	header := fileFrameHeader{}
+
+	if err := json.NewDecoder(io.LimitReader(file, int64(size))).Decode(&header); err != nil {
+		return nil, fmt.Errorf("frame %d: %w", id, err)
+	}
+
+	return &pb.Frame{
+		Id:          id,
+		TimestampMs: header.TimestampMs,
+		Width:       header.Width,
+		Height:      header.Height,
+		Encoding:    header.Encoding,
+		Checksum:    header.Checksum,
+	}, nil
+}
+
+// path only ever builds names from the numeric id, so a frame can never be
+// written outside of dir.
+func (f *FileFrameStore) path(id int32) (string, error) {
+	if id <= 0 {
+		return "", fmt.Errorf("invalid frame id %d", id)
+	}
+
+	return filepath.Join(f.dir, strconv.Itoa(int(id))+frameFileExt), nil
+}
+
+func encodeFrameFile(frame *pb.Frame) ([]byte, error) {
+	header, err := json.Marshal(fileFrameHeader{
+		TimestampMs: frame.TimestampMs,
+		Width:       frame.Width,
+		Height:      frame.Height,
+		Encoding:    frame.Encoding,
+		Checksum:    frame.Checksum,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	buf := &bytes.Buffer{}
+	binary.Write(buf, binary.BigEndian, uint32(len(header)))
+	buf.Write(header)
+	buf.Write(frame.Data)
+
+	return buf.Bytes(), nil
+}
+
+func decodeFrameFile(id int32, content []byte) (*pb.Frame, error) {
+	if len(content) < 4 {
+		return nil, fmt.Errorf("frame %d: truncated file", id)
+	}
+
+	size := binary.BigEndian.Uint32(content)
+	content = content[4:]
+
+	if uint32(len(content)) < size {
+		return nil, fmt.Errorf("frame %d: truncated header", id)
+	}
+
+	header := fileFrameHeader{}
+
+	if err := json.Unmarshal(content[:size], &header); err != nil {
+		return nil, fmt.Errorf("frame %d: %w", id, err)
+	}
+
+	return &pb.Frame{
+		Id:          id,
+		Data:        content[size:],
+		TimestampMs: header.TimestampMs,
+		Width:       header.Width,
+		Height:      header.Height,
+		Encoding:    header.Encoding,
+		Checksum:    header.Checksum,
+	}, nil
+}
@@ -0,0 +1,50 @@
+package internal
+
+import (
+	"sync"
+
+	"github.com/uandersonricardo/masterclass-go/pkg/pb"
+)
+
+// Frames published while a subscriber's buffer is full are dropped for that
+// subscriber, so a slow consumer never blocks the others.
+const subscriberBufferSize = 64
+
+type frameBroker struct {
+	mu          sync.Mutex
+	subscribers map[chan *pb.Frame]struct{}
+}
+
+func newFrameBroker() *frameBroker {
+	return &frameBroker{
+		subscribers: make(map[chan *pb.Frame]struct{}),
+	}
+}
+
+func (b *frameBroker) subscribe() chan *pb.Frame {
+	ch := make(chan *pb.Frame, subscriberBufferSize)
+
+	b.mu.Lock()
+	b.subscribers[ch] = struct{}{}
+	b.mu.Unlock()
+
+	return ch
+}
+
+func (b *frameBroker) unsubscribe(ch chan *pb.Frame) {
+	b.mu.Lock()
+	delete(b.subscribers, ch)
+	b.mu.Unlock()
+}
+
+func (b *frameBroker) publish(frame *pb.Frame) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for ch := range b.subscribers {
+		select {
+		case ch <- frame:
+		default:
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package internal
+
+import (
+	"context"
+	"errors"
+
+	"github.com/uandersonricardo/masterclass-go/pkg/pb"
+)
+
+var (
+	ErrFrameNotFound = errors.New("frame not found")
+	ErrFrameExists   = errors.New("frame already exists")
+)
+
+type FrameStore interface {
+	Get(ctx context.Context, id int32) (*pb.Frame, error)
+	Put(ctx context.Context, frame *pb.Frame) error
+	// PutIfAbsent stores frame unless one with the same id already exists,
+	// in which case it returns ErrFrameExists. The check and the write are
+	// atomic.
+	PutIfAbsent(ctx context.Context, frame *pb.Frame) error
+	Delete(ctx context.Context, id int32) error
+	// List returns up to limit frames with an id greater than afterID,
+	// ordered by id.
+	List(ctx context.Context, afterID int32, limit int) ([]*pb.Frame, error)
+	// ListHeaders is List without the frame data, for callers that only need
+	// the metadata.
+	ListHeaders(ctx context.Context, afterID int32, limit int) ([]*pb.Frame, error)
+}
+
+// frameHeader copies everything but the data of frame.
+func frameHeader(frame *pb.Frame) *pb.Frame {
+	return &pb.Frame{
+		Id:          frame.Id,
+		TimestampMs: frame.TimestampMs,
+		Width:       frame.Width,
+		Height:      frame.Height,
+		Encoding:    frame.Encoding,
+		Checksum:    frame.Checksum,
+	}
+}
@@ -0,0 +1,77 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/uandersonricardo/masterclass-go/pkg/pb"
+)
+
+func testStores(t *testing.T) map[string]FrameStore {
+	t.Helper()
+
+	file, err := NewFileFrameStore(t.TempDir())
+
+	if err != nil {
+		t.Fatalf("NewFileFrameStore() = %v", err)
+	}
+
+	return map[string]FrameStore{
+		"memory":  NewMemoryFrameStore(),
+		"file":    file,
+		"caching": NewCachingFrameStore(NewMemoryFrameStore()),
+	}
+}
+
+func TestPutIfAbsent(t *testing.T) {
+	ctx := context.Background()
+
+	for name, store := range testStores(t) {
+		t.Run(name, func(t *testing.T) {
+			if err := store.PutIfAbsent(ctx, &pb.Frame{Id: 1, Data: []byte("first")}); err != nil {
+				t.Fatalf("first PutIfAbsent() = %v", err)
+			}
+
+			err := store.PutIfAbsent(ctx, &pb.Frame{Id: 1, Data: []byte("second")})
+
+			if !errors.Is(err, ErrFrameExists) {
+				t.Errorf("second PutIfAbsent() = %v, want %v", err, ErrFrameExists)
+			}
+
+			frame, err := store.Get(ctx, 1)
+
+			if err != nil || string(frame.Data) != "first" {
+				t.Errorf("Get() = %v, %v, want the first frame", frame, err)
+			}
+		})
+	}
+}
+
+func TestListHeaders(t *testing.T) {
+	ctx := context.Background()
+
+	for name, store := range testStores(t) {
+		t.Run(name, func(t *testing.T) {
+			for id := int32(1); id <= 3; id++ {
+				store.Put(ctx, &pb.Frame{Id: id, Data: []byte("data"), Encoding: "png", Width: 4, Height: 2})
+			}
+
+			frames, err := store.ListHeaders(ctx, 1, 10)
+
+			if err != nil {
+				t.Fatalf("ListHeaders() = %v", err)
+			}
+
+			if len(frames) != 2 || frames[0].Id != 2 || frames[1].Id != 3 {
+				t.Fatalf("ListHeaders() = %v, want frames 2 and 3", frames)
+			}
+
+			for _, frame := range frames {
+				if frame.Data != nil || frame.Encoding != "png" || frame.Width != 4 || frame.Height != 2 {
+					t.Errorf("ListHeaders() frame = %v, want png 4x2 without data", frame)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/uandersonricardo/masterclass-go/internal"
+	"github.com/uandersonricardo/masterclass-go/pkg/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// NewHandler proxies the HTTP/JSON routes declared in the proto to the gRPC
+// server at grpcAddr, dialing it with creds or in plaintext when creds is
+// nil. gRPC status codes are translated to HTTP statuses by
+// runtime.HTTPStatusFromCode, e.g. NotFound to 404 and InvalidArgument to 400.
+func NewHandler(ctx context.Context, grpcAddr string, creds credentials.TransportCredentials) (http.Handler, error) {
+	if creds == nil {
+		creds = insecure.NewCredentials()
+	}
+
+	mux := runtime.NewServeMux(runtime.WithIncomingHeaderMatcher(headerMatcher))
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(creds),
+	}
+
+	err := pb.RegisterFrameServiceHandlerFromEndpoint(ctx, mux, grpcAddr, opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return mux, nil
+}
+
+type Gateway struct {
+	server *http.Server
+}
+
+func NewGateway(grpcAddr, httpAddr string, creds credentials.TransportCredentials) (*Gateway, error) {
+	handler, err := NewHandler(context.Background(), grpcAddr, creds)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &Gateway{
+		server: &http.Server{
+			Addr:    httpAddr,
+			Handler: handler,
+		},
+	}, nil
+}
+
+// StartGateway serves the gateway on httpAddr, dialing grpcAddr in
+// plaintext. Use NewGateway to dial over TLS or to stop the gateway.
+func StartGateway(grpcAddr, httpAddr string) error {
+	gw, err := NewGateway(grpcAddr, httpAddr, nil)
+
+	if err != nil {
+		return err
+	}
+
+	return gw.Start()
+}
+
+// Start serves HTTP until Stop is called, after which it returns nil. A
+// taken address is reported as internal.ErrAddressInUse.
+func (g *Gateway) Start() error {
+	lis, err := internal.Listen(g.server.Addr)
+
+	if err != nil {
+		return fmt.Errorf("failed to listen for the gateway: %w", err)
+	}
+
+	err = g.server.Serve(lis)
+
+	if errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+
+	return err
+}
+
+// Stop stops accepting requests and waits for in-flight ones to finish or
+// for ctx to expire.
+func (g *Gateway) Stop(ctx context.Context) error {
+	return g.server.Shutdown(ctx)
+}
+
+// headerMatcher also forwards X-Request-Id so logs from the gateway and the
+// gRPC server can be correlated.
+func headerMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, "X-Request-Id") {
+		return "x-request-id", true
+	}
+
+	return runtime.DefaultHeaderMatcher(key)
+}
@@ -0,0 +1,174 @@
+package gateway
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/uandersonricardo/masterclass-go/internal"
+)
+
+// writeSelfSignedCert writes a certificate for name and its key to dir.
+func writeSelfSignedCert(t *testing.T, dir, name string) (certFile, keyFile string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certFile = filepath.Join(dir, "cert.pem")
+	keyFile = filepath.Join(dir, "key.pem")
+
+	if err := os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	return certFile, keyFile
+}
+
+// getOverTLS serves a TLS gRPC server with config's certificate and
+// returns the status of GET /v1/frames/1 through a gateway dialing it on
+// localhost.
+func getOverTLS(t *testing.T, config internal.Config) int {
+	t.Helper()
+
+	server := internal.NewGrpcServer("localhost:0", internal.NewMemoryFrameStore(), internal.WithTLS(config.TLSCert, config.TLSKey))
+	errs := make(chan error, 1)
+
+	go func() {
+		errs <- server.Start()
+	}()
+
+	select {
+	case <-server.Ready():
+	case err := <-errs:
+		t.Fatalf("Start() = %v", err)
+	}
+
+	defer server.Stop(context.Background())
+
+	creds, err := config.GatewayCredentials()
+
+	if err != nil {
+		t.Fatalf("GatewayCredentials() = %v", err)
+	}
+
+	_, port, _ := net.SplitHostPort(server.Addr().String())
+	handler, err := NewHandler(context.Background(), "localhost:"+port, creds)
+
+	if err != nil {
+		t.Fatalf("NewHandler() = %v", err)
+	}
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/frames/1", nil))
+
+	return rec.Code
+}
+
+func TestGatewayOverTLS(t *testing.T) {
+	certFile, keyFile := writeSelfSignedCert(t, t.TempDir(), "localhost")
+
+	// A 404 means the call reached the server; a failed handshake is a 503.
+	if code := getOverTLS(t, internal.Config{TLSCert: certFile, TLSKey: keyFile}); code != http.StatusNotFound {
+		t.Errorf("GET /v1/frames/1 = %d, want %d", code, http.StatusNotFound)
+	}
+}
+
+func TestGatewayTLSServerName(t *testing.T) {
+	certFile, keyFile := writeSelfSignedCert(t, t.TempDir(), "frames.internal")
+	config := internal.Config{TLSCert: certFile, TLSKey: keyFile}
+
+	if code := getOverTLS(t, config); code != http.StatusServiceUnavailable {
+		t.Errorf("GET /v1/frames/1 without server name = %d, want %d", code, http.StatusServiceUnavailable)
+	}
+
+	config.GatewayTLSServerName = "frames.internal"
+
+	if code := getOverTLS(t, config); code != http.StatusNotFound {
+		t.Errorf("GET /v1/frames/1 with server name = %d, want %d", code, http.StatusNotFound)
+	}
+}
+
+func TestGatewayStop(t *testing.T) {
+	gw, err := NewGateway("localhost:0", "127.0.0.1:0", nil)
+
+	if err != nil {
+		t.Fatalf("NewGateway() = %v", err)
+	}
+
+	errs := make(chan error, 1)
+
+	go func() {
+		errs <- gw.Start()
+	}()
+
+	if err := gw.Stop(context.Background()); err != nil {
+		t.Fatalf("Stop() = %v", err)
+	}
+
+	if err := <-errs; err != nil {
+		t.Errorf("Start() after Stop() = %v, want nil", err)
+	}
+}
+
+func TestGatewayAddressInUse(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer lis.Close()
+
+	gw, err := NewGateway("localhost:0", lis.Addr().String(), nil)
+
+	if err != nil {
+		t.Fatalf("NewGateway() = %v", err)
+	}
+
+	if err := gw.Start(); !errors.Is(err, internal.ErrAddressInUse) {
+		t.Errorf("Start() = %v, want %v", err, internal.ErrAddressInUse)
+	}
+}
@@ -2,41 +2,724 @@ package internal
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"math"
 	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/uandersonricardo/masterclass-go/pkg/pb"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	ErrServerNotStarted     = errors.New("server not started")
+	ErrServerAlreadyStarted = errors.New("server already started")
 )
 
 type GrpcServer struct {
-	address string
-	server  *grpc.Server
+	addresses []string
+	server    *grpc.Server
+	health    *health.Server
+	store     FrameStore
+	broker    *frameBroker
+	opts      *options
+	initErr   error
+
+	mu            sync.Mutex
+	started       bool
+	ready         chan struct{}
+	addrs         []net.Addr
+	metricsServer *http.Server
 
 	pb.UnimplementedFrameServiceServer
 }
 
-func NewGrpcServer(address string) *GrpcServer {
-	server := grpc.NewServer()
+func NewGrpcServer(address string, store FrameStore, opts ...Option) *GrpcServer {
+	o := defaultOptions()
+
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	serverOpts, err := o.grpcServerOptions()
+
+	if err != nil {
+		err = fmt.Errorf("failed to configure server: %w", err)
+	}
+
+	server := grpc.NewServer(serverOpts...)
+
+	if o.verifyChecksums {
+		store = checksumFrameStore{store}
+	}
+
+	s := &GrpcServer{
+		addresses: append([]string{address}, o.extraAddresses...),
+		server:    server,
+		health:    health.NewServer(),
+		store:     store,
+		broker:    newFrameBroker(),
+		opts:      o,
+		ready:     make(chan struct{}),
+		initErr:   err,
+	}
+
+	pb.RegisterFrameServiceServer(server, s)
+	healthpb.RegisterHealthServer(server, s.health)
+
+	if o.reflection {
+		reflection.Register(server)
+	}
 
-	return &GrpcServer{
-		address: address,
-		server:  server,
+	if o.metrics != nil {
+		o.metrics.initialize(server)
 	}
+
+	return s
 }
 
+// Start listens on the configured addresses and serves until the server
+// stops. A server can only be started once, either by Start or by Serve.
 func (s *GrpcServer) Start() error {
-	pb.RegisterFrameServiceServer(s.server, s)
-	lis, err := net.Listen("tcp", s.address)
+	if err := s.claim(); err != nil {
+		return err
+	}
+
+	listeners, err := listenAll(s.addresses)
 
 	if err != nil {
+		s.release()
+		return err
+	}
+
+	return s.serve(listeners)
+}
+
+// Serve serves on an existing listener instead of the configured addresses,
+// e.g. a bufconn.Listener in tests. Like Start, it blocks until the server
+// stops.
+func (s *GrpcServer) Serve(lis net.Listener) error {
+	if err := s.claim(); err != nil {
+		lis.Close()
 		return err
 	}
 
-	return s.server.Serve(lis)
+	return s.serve([]net.Listener{lis})
+}
+
+// claim marks the server as started, failing if Start or Serve already ran.
+func (s *GrpcServer) claim() error {
+	if s.initErr != nil {
+		return s.initErr
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.started {
+		return ErrServerAlreadyStarted
+	}
+
+	s.started = true
+	return nil
+}
+
+// release undoes claim when the server fails before serving.
+func (s *GrpcServer) release() {
+	s.mu.Lock()
+	s.started = false
+	s.mu.Unlock()
+}
+
+func (s *GrpcServer) serve(listeners []net.Listener) error {
+	if s.opts.metricsAddress != "" {
+		err := s.startMetricsServer()
+
+		if err != nil {
+			closeAll(listeners)
+			s.release()
+			return err
+		}
+	}
+
+	s.health.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+	s.health.SetServingStatus(pb.FrameService_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_SERVING)
+
+	s.mu.Lock()
+
+	for _, lis := range listeners {
+		s.addrs = append(s.addrs, lis.Addr())
+	}
+
+	close(s.ready)
+	s.mu.Unlock()
+
+	for _, lis := range listeners {
+		s.opts.logger.Info("server listening", slog.String("address", lis.Addr().String()))
+	}
+
+	return s.serveAll(listeners)
+}
+
+// serveAll serves every listener in its own goroutine. The first one to fail
+// stops the server, which closes the others, and its error is returned.
+func (s *GrpcServer) serveAll(listeners []net.Listener) error {
+	errs := make(chan error, len(listeners))
+
+	for _, lis := range listeners {
+		go func(lis net.Listener) {
+			errs <- s.server.Serve(lis)
+		}(lis)
+	}
+
+	var first error
+
+	for range listeners {
+		if err := <-errs; err != nil && first == nil {
+			first = err
+			s.server.Stop()
+		}
+	}
+
+	return first
+}
+
+// Ready is closed once Start or Serve is listening and about to serve
+// connections.
+func (s *GrpcServer) Ready() <-chan struct{} {
+	return s.ready
+}
+
+// Addr returns the address the server is listening on, which resolves the
+// port when binding to ":0". It is nil until the server is listening.
+func (s *GrpcServer) Addr() net.Addr {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.addrs) == 0 {
+		return nil
+	}
+
+	return s.addrs[0]
+}
+
+// Addrs returns the addresses of every listener, starting with the one
+// passed to NewGrpcServer.
+func (s *GrpcServer) Addrs() []net.Addr {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]net.Addr(nil), s.addrs...)
+}
+
+func (s *GrpcServer) Stop(ctx context.Context) error {
+	s.mu.Lock()
+	started := s.started
+	metricsServer := s.metricsServer
+	s.mu.Unlock()
+
+	if !started {
+		return ErrServerNotStarted
+	}
+
+	if metricsServer != nil {
+		metricsServer.Shutdown(ctx)
+	}
+
+	s.opts.logger.Info("stopping server")
+	s.health.Shutdown()
+	done := make(chan struct{})
+
+	go func() {
+		s.server.GracefulStop()
+		close(done)
+	}()
+
+	var err error
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+		s.opts.logger.Warn("graceful stop timed out, closing remaining connections")
+		s.server.Stop()
+		<-done
+		err = ctx.Err()
+	}
+
+	for _, addr := range s.Addrs() {
+		if addr.Network() == "unix" {
+			removeSocket(addr.String())
+		}
+	}
+
+	s.opts.logger.Info("server stopped")
+	return err
+}
+
+// Shutdown reports NOT_SERVING through the health service, waits drainDelay
+// for load balancers to stop routing new calls, and then stops the server
+// like Stop.
+func (s *GrpcServer) Shutdown(ctx context.Context, drainDelay time.Duration) error {
+	s.mu.Lock()
+	started := s.started
+	s.mu.Unlock()
+
+	if !started {
+		return ErrServerNotStarted
+	}
+
+	s.SetServingStatus("", false)
+	s.SetServingStatus(pb.FrameService_ServiceDesc.ServiceName, false)
+	s.opts.logger.Info("draining server", slog.Duration("delay", drainDelay))
+
+	timer := time.NewTimer(drainDelay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+	case <-ctx.Done():
+	}
+
+	return s.Stop(ctx)
+}
+
+// SetDraining makes the server refuse new calls with codes.Unavailable while
+// draining is set. Calls that already started are not affected.
+func (s *GrpcServer) SetDraining(draining bool) {
+	if s.opts.drain.draining.Swap(draining) != draining {
+		s.opts.logger.Info("draining mode changed", slog.Bool("draining", draining))
+	}
+}
+
+// MetricsHandler serves the collected metrics on /metrics, or returns nil
+// when the server was built without WithMetrics.
+func (s *GrpcServer) MetricsHandler() http.Handler {
+	if s.opts.metrics == nil {
+		return nil
+	}
+
+	return s.opts.metrics.handler()
+}
+
+func (s *GrpcServer) startMetricsServer() error {
+	lis, err := Listen(s.opts.metricsAddress)
+
+	if err != nil {
+		return fmt.Errorf("failed to listen for metrics: %w", err)
+	}
+
+	metricsServer := &http.Server{
+		Handler: s.MetricsHandler(),
+	}
+
+	s.mu.Lock()
+	s.metricsServer = metricsServer
+	s.mu.Unlock()
+
+	s.opts.logger.Info("metrics listening", slog.String("address", lis.Addr().String()))
+
+	go metricsServer.Serve(lis)
+	return nil
+}
+
+func (s *GrpcServer) SetServingStatus(service string, serving bool) {
+	servingStatus := healthpb.HealthCheckResponse_NOT_SERVING
+
+	if serving {
+		servingStatus = healthpb.HealthCheckResponse_SERVING
+	}
+
+	s.health.SetServingStatus(service, servingStatus)
+}
+
+// isHealthMethod reports whether method belongs to the health service, which
+// interceptors that shed load let through so probes keep working.
+func isHealthMethod(method string) bool {
+	return strings.HasPrefix(method, "/"+healthpb.Health_ServiceDesc.ServiceName+"/")
 }
 
 func (s *GrpcServer) GetFrame(ctx context.Context, req *pb.GetFrameRequest) (*pb.Frame, error) {
-	return &pb.Frame{
-		Id: req.Id,
+	if err := ctx.Err(); err != nil {
+		return nil, status.FromContextError(err).Err()
+	}
+
+	trace.SpanFromContext(ctx).SetAttributes(attribute.Int("frame.id", int(req.Id)))
+
+	frame, err := s.store.Get(ctx, req.Id)
+
+	if err != nil {
+		return nil, storeStatus(ctx, err, req.Id)
+	}
+
+	if req.MaxWidth == 0 && req.MaxHeight == 0 {
+		return frame, nil
+	}
+
+	thumb, err := thumbnail(frame, req.MaxWidth, req.MaxHeight)
+
+	if errors.Is(err, ErrUnsupportedEncoding) || errors.Is(err, ErrImageTooLarge) {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot scale frame %d: %v", req.Id, err)
+	}
+
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to scale frame %d: %v", req.Id, err)
+	}
+
+	return thumb, nil
+}
+
+func (s *GrpcServer) StreamFrames(req *pb.StreamFramesRequest, stream pb.FrameService_StreamFramesServer) error {
+	step := int32(1)
+
+	if req.Step != nil {
+		step = req.GetStep()
+	}
+
+	if step <= 0 {
+		return status.Errorf(codes.InvalidArgument, "step must be positive, got %d", step)
+	}
+
+	if req.EndId < req.StartId {
+		return status.Errorf(codes.InvalidArgument, "end_id %d is before start_id %d", req.EndId, req.StartId)
+	}
+
+	// Page through the stored frames rather than probing every id, so a
+	// wide, sparse range costs no more than the frames it holds.
+	start, end := int64(req.StartId), int64(req.EndId)
+	afterID := start - 1
+
+	for afterID < end {
+		if err := stream.Context().Err(); err != nil {
+			return status.FromContextError(err).Err()
+		}
+
+		limit := int(min(end-afterID, maxPageSize))
+		frames, err := s.store.List(stream.Context(), int32(max(afterID, math.MinInt32)), limit)
+
+		if err != nil {
+			return listStatus(stream.Context(), err)
+		}
+
+		for _, frame := range frames {
+			id := int64(frame.Id)
+
+			if id > end {
+				return nil
+			}
+
+			if (id-start)%int64(step) == 0 {
+				if err := stream.Send(frame); err != nil {
+					return err
+				}
+			}
+
+			afterID = id
+		}
+
+		if len(frames) < limit {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+func (s *GrpcServer) UploadFrames(stream pb.FrameService_UploadFramesServer) error {
+	res := &pb.UploadFramesResponse{}
+
+	for {
+		frame, err := stream.Recv()
+
+		if err == io.EOF {
+			return stream.SendAndClose(res)
+		}
+
+		if err != nil {
+			return status.Errorf(status.Code(err), "upload aborted after %d frames: %v", res.Count, err)
+		}
+
+		if frame.Validate() != nil || s.prepareFrame(frame) != nil {
+			res.FailedIds = append(res.FailedIds, frame.Id)
+			continue
+		}
+
+		if err := s.store.Put(stream.Context(), frame); err != nil {
+			res.FailedIds = append(res.FailedIds, frame.Id)
+			continue
+		}
+
+		s.broker.publish(frame)
+
+		res.Count++
+	}
+}
+
+func (s *GrpcServer) ListFrames(ctx context.Context, req *pb.ListFramesRequest) (*pb.ListFramesResponse, error) {
+	afterID, err := decodePageToken(req.PageToken)
+
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	pageSize := clampPageSize(req.PageSize)
+	frames, err := s.store.ListHeaders(ctx, afterID, pageSize+1)
+
+	if err != nil {
+		return nil, listStatus(ctx, err)
+	}
+
+	res := &pb.ListFramesResponse{}
+
+	if len(frames) > pageSize {
+		frames = frames[:pageSize]
+		res.NextPageToken = encodePageToken(frames[len(frames)-1].Id)
+	}
+
+	res.Frames = frames
+	return res, nil
+}
+
+func (s *GrpcServer) DeleteFrame(ctx context.Context, req *pb.DeleteFrameRequest) (*pb.DeleteFrameResponse, error) {
+	err := s.store.Delete(ctx, req.Id)
+
+	if errors.Is(err, ErrFrameNotFound) {
+		return nil, newNotFoundStatus(req.Id)
+	}
+
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete frame %d: %v", req.Id, err)
+	}
+
+	return &pb.DeleteFrameResponse{
+		Deleted: true,
 	}, nil
 }
+
+func (s *GrpcServer) WatchFrames(stream pb.FrameService_WatchFramesServer) error {
+	ctx := stream.Context()
+	frames := s.broker.subscribe()
+	defer s.broker.unsubscribe(frames)
+
+	requests := make(chan *pb.WatchRequest)
+	recvErr := make(chan error, 1)
+
+	go func() {
+		for {
+			req, err := stream.Recv()
+
+			if err != nil {
+				recvErr <- err
+				return
+			}
+
+			select {
+			case requests <- req:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	paused := false
+
+	for {
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case err := <-recvErr:
+			if err == io.EOF {
+				return nil
+			}
+
+			return err
+		case req := <-requests:
+			switch req.Command {
+			case pb.WatchRequest_PAUSE:
+				paused = true
+			case pb.WatchRequest_RESUME:
+				paused = false
+			case pb.WatchRequest_SEEK:
+				if err := s.replayFrames(stream, req.SeekId); err != nil {
+					return err
+				}
+			}
+		case frame := <-frames:
+			if paused {
+				continue
+			}
+
+			if err := stream.Send(frame); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *GrpcServer) replayFrames(stream pb.FrameService_WatchFramesServer, fromID int32) error {
+	afterID := fromID - 1
+
+	for {
+		frames, err := s.store.List(stream.Context(), afterID, maxPageSize)
+
+		if err != nil {
+			return listStatus(stream.Context(), err)
+		}
+
+		for _, frame := range frames {
+			if err := stream.Send(frame); err != nil {
+				return err
+			}
+
+			afterID = frame.Id
+		}
+
+		if len(frames) < maxPageSize {
+			return nil
+		}
+	}
+}
+
+func (s *GrpcServer) BatchGetFrames(ctx context.Context, req *pb.BatchGetFramesRequest) (*pb.BatchGetFramesResponse, error) {
+	if len(req.Ids) > maxBatchSize {
+		return nil, status.Errorf(codes.InvalidArgument, "batch of %d ids exceeds the maximum of %d", len(req.Ids), maxBatchSize)
+	}
+
+	res := &pb.BatchGetFramesResponse{}
+
+	for i, result := range batchGet(ctx, s.store, req.Ids, s.opts.batchConcurrency) {
+		if errors.Is(result.err, ErrFrameNotFound) {
+			res.NotFoundIds = append(res.NotFoundIds, req.Ids[i])
+			continue
+		}
+
+		if result.err != nil {
+			return nil, storeStatus(ctx, result.err, req.Ids[i])
+		}
+
+		res.Frames = append(res.Frames, result.frame)
+	}
+
+	return res, nil
+}
+
+func (s *GrpcServer) ConvertFrame(ctx context.Context, req *pb.ConvertFrameRequest) (*pb.Frame, error) {
+	frame, err := s.store.Get(ctx, req.Id)
+
+	if err != nil {
+		return nil, storeStatus(ctx, err, req.Id)
+	}
+
+	converted, err := convertFrame(frame, req.Encoding)
+
+	if errors.Is(err, ErrUnimplementedEncoder) {
+		return nil, status.Error(codes.Unimplemented, err.Error())
+	}
+
+	if errors.Is(err, ErrUnsupportedEncoding) || errors.Is(err, ErrImageTooLarge) {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot decode frame %d: %v", req.Id, err)
+	}
+
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert frame %d: %v", req.Id, err)
+	}
+
+	return converted, nil
+}
+
+func (s *GrpcServer) PutFrame(ctx context.Context, req *pb.PutFrameRequest) (*pb.PutFrameResponse, error) {
+	frame := req.Frame
+
+	if err := s.prepareFrame(frame); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "frame %d: %v", frame.Id, err)
+	}
+
+	err := s.store.PutIfAbsent(ctx, frame)
+	created := err == nil
+
+	if errors.Is(err, ErrFrameExists) && req.IfNotExists {
+		return nil, status.Errorf(codes.AlreadyExists, "frame %d already exists", frame.Id)
+	}
+
+	if errors.Is(err, ErrFrameExists) {
+		err = s.store.Put(ctx, frame)
+	}
+
+	if err != nil && ctx.Err() != nil {
+		return nil, status.FromContextError(ctx.Err()).Err()
+	}
+
+	if errors.Is(err, ErrChecksumMismatch) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to put frame %d: %v", frame.Id, err)
+	}
+
+	s.broker.publish(frame)
+
+	return &pb.PutFrameResponse{
+		Id:      frame.Id,
+		Created: created,
+	}, nil
+}
+
+// prepareFrame fills in what the store would add to frame before it is
+// stored, so watchers are published the same frame readers get back.
+func (s *GrpcServer) prepareFrame(frame *pb.Frame) error {
+	if err := fillFrameMetadata(frame); err != nil {
+		return err
+	}
+
+	if s.opts.verifyChecksums && frame.Checksum == "" {
+		frame.Checksum = frameChecksum(frame.Data)
+	}
+
+	return nil
+}
+
+func (s *GrpcServer) GetFrameRange(ctx context.Context, req *pb.GetFrameRangeRequest) (*pb.FrameSequence, error) {
+	if req.Count > maxRangeCount {
+		return nil, status.Errorf(codes.InvalidArgument, "count %d exceeds the maximum of %d", req.Count, maxRangeCount)
+	}
+
+	ids := make([]int32, 0, req.Count)
+
+	for id := int64(req.StartId); id < int64(req.StartId)+int64(req.Count) && id <= math.MaxInt32; id++ {
+		ids = append(ids, int32(id))
+	}
+
+	res := &pb.FrameSequence{}
+
+	for i, result := range batchGet(ctx, s.store, ids, s.opts.batchConcurrency) {
+		if errors.Is(result.err, ErrFrameNotFound) {
+			if i == 0 {
+				return nil, newNotFoundStatus(req.StartId)
+			}
+
+			res.MissingIds = append(res.MissingIds, ids[i])
+			continue
+		}
+
+		if result.err != nil {
+			return nil, storeStatus(ctx, result.err, ids[i])
+		}
+
+		res.Frames = append(res.Frames, result.frame)
+	}
+
+	return res, nil
+}
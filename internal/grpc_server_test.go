@@ -0,0 +1,469 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/png"
+	"io"
+	"io/fs"
+	"math"
+	"net"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/uandersonricardo/masterclass-go/pkg/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	reflectionpb "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+)
+
+// startTestServer serves s over an in-memory listener and returns a
+// connection to it. Both are torn down when the test ends.
+func startTestServer(t *testing.T, s *GrpcServer) *grpc.ClientConn {
+	t.Helper()
+
+	lis := bufconn.Listen(1 << 20)
+	errs := make(chan error, 1)
+
+	go func() {
+		errs <- s.Serve(lis)
+	}()
+
+	select {
+	case <-s.Ready():
+	case err := <-errs:
+		t.Fatalf("Serve() = %v", err)
+	}
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+
+	if err != nil {
+		t.Fatalf("NewClient() = %v", err)
+	}
+
+	t.Cleanup(func() {
+		conn.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		s.Stop(ctx)
+	})
+
+	return conn
+}
+
+func newTestClient(t *testing.T, opts ...Option) pb.FrameServiceClient {
+	t.Helper()
+
+	s := NewGrpcServer("127.0.0.1:0", NewMemoryFrameStore(), opts...)
+	return pb.NewFrameServiceClient(startTestServer(t, s))
+}
+
+func testPNG(t *testing.T, width, height int) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+
+	if err := png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, width, height))); err != nil {
+		t.Fatalf("png.Encode() = %v", err)
+	}
+
+	return buf.Bytes()
+}
+
+func putTestFrame(t *testing.T, client pb.FrameServiceClient, id int32) {
+	t.Helper()
+
+	_, err := client.PutFrame(context.Background(), &pb.PutFrameRequest{
+		Frame: &pb.Frame{Id: id, Data: testPNG(t, 4, 4)},
+	})
+
+	if err != nil {
+		t.Fatalf("PutFrame(%d) = %v", id, err)
+	}
+}
+
+func TestPutAndGetFrame(t *testing.T) {
+	client := newTestClient(t)
+	putTestFrame(t, client, 1)
+
+	frame, err := client.GetFrame(context.Background(), &pb.GetFrameRequest{Id: 1})
+
+	if err != nil {
+		t.Fatalf("GetFrame() = %v", err)
+	}
+
+	if frame.Encoding != "png" || frame.Width != 4 || frame.Height != 4 {
+		t.Errorf("GetFrame() = %s %dx%d, want png 4x4", frame.Encoding, frame.Width, frame.Height)
+	}
+}
+
+func TestGetFrameNotFound(t *testing.T) {
+	client := newTestClient(t)
+
+	_, err := client.GetFrame(context.Background(), &pb.GetFrameRequest{Id: 1})
+
+	if code := status.Code(err); code != codes.NotFound {
+		t.Errorf("GetFrame() code = %v, want %v", code, codes.NotFound)
+	}
+}
+
+func TestServeTwice(t *testing.T) {
+	s := NewGrpcServer("127.0.0.1:0", NewMemoryFrameStore())
+	startTestServer(t, s)
+
+	err := s.Serve(bufconn.Listen(1 << 10))
+
+	if !errors.Is(err, ErrServerAlreadyStarted) {
+		t.Errorf("second Serve() = %v, want %v", err, ErrServerAlreadyStarted)
+	}
+
+	err = s.Start()
+
+	if !errors.Is(err, ErrServerAlreadyStarted) {
+		t.Errorf("Start() after Serve() = %v, want %v", err, ErrServerAlreadyStarted)
+	}
+}
+
+func TestGetFrameRange(t *testing.T) {
+	client := newTestClient(t)
+	putTestFrame(t, client, 1)
+	putTestFrame(t, client, 3)
+	ctx := context.Background()
+
+	res, err := client.GetFrameRange(ctx, &pb.GetFrameRangeRequest{StartId: 1, Count: 4})
+
+	if err != nil {
+		t.Fatalf("GetFrameRange() = %v", err)
+	}
+
+	if len(res.Frames) != 2 || res.Frames[0].Id != 1 || res.Frames[1].Id != 3 {
+		t.Errorf("GetFrameRange() frames = %v, want ids 1 and 3", res.Frames)
+	}
+
+	if want := []int32{2, 4}; !slices.Equal(res.MissingIds, want) {
+		t.Errorf("GetFrameRange() missing ids = %v, want %v", res.MissingIds, want)
+	}
+
+	_, err = client.GetFrameRange(ctx, &pb.GetFrameRangeRequest{StartId: 2, Count: 2})
+
+	if code := status.Code(err); code != codes.NotFound {
+		t.Errorf("GetFrameRange() with missing start code = %v, want %v", code, codes.NotFound)
+	}
+
+	_, err = client.GetFrameRange(ctx, &pb.GetFrameRangeRequest{StartId: 1, Count: maxRangeCount + 1})
+
+	if code := status.Code(err); code != codes.InvalidArgument {
+		t.Errorf("GetFrameRange() over the maximum code = %v, want %v", code, codes.InvalidArgument)
+	}
+}
+
+func TestStreamFramesWideRange(t *testing.T) {
+	client := newTestClient(t)
+
+	for _, id := range []int32{1, 2, 3, 4, 6, 9, 1<<30 + 1} {
+		putTestFrame(t, client, id)
+	}
+
+	// Probing every id up to MaxInt32 would not finish within the deadline.
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	stream, err := client.StreamFrames(ctx, &pb.StreamFramesRequest{StartId: 1, EndId: math.MaxInt32, Step: proto.Int32(2)})
+
+	if err != nil {
+		t.Fatalf("StreamFrames() = %v", err)
+	}
+
+	var ids []int32
+
+	for {
+		frame, err := stream.Recv()
+
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			t.Fatalf("StreamFrames() Recv = %v", err)
+		}
+
+		ids = append(ids, frame.Id)
+	}
+
+	if want := []int32{1, 3, 9, 1<<30 + 1}; !slices.Equal(ids, want) {
+		t.Errorf("StreamFrames() ids = %v, want %v", ids, want)
+	}
+}
+
+func TestPutFrameIfNotExistsRace(t *testing.T) {
+	client := newTestClient(t)
+	data := testPNG(t, 4, 4)
+
+	const callers = 20
+	created := make(chan bool, callers)
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			res, err := client.PutFrame(context.Background(), &pb.PutFrameRequest{
+				Frame:       &pb.Frame{Id: 1, Data: data},
+				IfNotExists: true,
+			})
+
+			if err != nil && status.Code(err) != codes.AlreadyExists {
+				t.Errorf("PutFrame() = %v", err)
+			}
+
+			created <- res.GetCreated()
+		}()
+	}
+
+	wg.Wait()
+	close(created)
+
+	n := 0
+
+	for c := range created {
+		if c {
+			n++
+		}
+	}
+
+	if n != 1 {
+		t.Errorf("%d calls created the frame, want 1", n)
+	}
+}
+
+func TestReflectionListsServices(t *testing.T) {
+	s := NewGrpcServer("127.0.0.1:0", NewMemoryFrameStore(), WithReflection())
+	client := reflectionpb.NewServerReflectionClient(startTestServer(t, s))
+
+	stream, err := client.ServerReflectionInfo(context.Background())
+
+	if err != nil {
+		t.Fatalf("ServerReflectionInfo() = %v", err)
+	}
+
+	err = stream.Send(&reflectionpb.ServerReflectionRequest{
+		MessageRequest: &reflectionpb.ServerReflectionRequest_ListServices{},
+	})
+
+	if err != nil {
+		t.Fatalf("Send() = %v", err)
+	}
+
+	res, err := stream.Recv()
+
+	if err != nil {
+		t.Fatalf("Recv() = %v", err)
+	}
+
+	var services []string
+
+	for _, service := range res.GetListServicesResponse().GetService() {
+		services = append(services, service.Name)
+	}
+
+	if !slices.Contains(services, pb.FrameService_ServiceDesc.ServiceName) {
+		t.Errorf("listed services = %v, want %s among them", services, pb.FrameService_ServiceDesc.ServiceName)
+	}
+}
+
+func TestGetFrameAfterDelete(t *testing.T) {
+	client := newTestClient(t)
+	putTestFrame(t, client, 1)
+	ctx := context.Background()
+
+	res, err := client.DeleteFrame(ctx, &pb.DeleteFrameRequest{Id: 1})
+
+	if err != nil || !res.Deleted {
+		t.Fatalf("DeleteFrame() = %v, %v", res, err)
+	}
+
+	_, err = client.GetFrame(ctx, &pb.GetFrameRequest{Id: 1})
+
+	if code := status.Code(err); code != codes.NotFound {
+		t.Errorf("GetFrame() after delete code = %v, want %v", code, codes.NotFound)
+	}
+
+	_, err = client.DeleteFrame(ctx, &pb.DeleteFrameRequest{Id: 1})
+
+	if code := status.Code(err); code != codes.NotFound {
+		t.Errorf("second DeleteFrame() code = %v, want %v", code, codes.NotFound)
+	}
+}
+
+func TestGetFrameCanceled(t *testing.T) {
+	s := NewGrpcServer("127.0.0.1:0", NewMemoryFrameStore())
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// Call the handler directly: a client would fail the call locally
+	// without reaching the server.
+	_, err := s.GetFrame(ctx, &pb.GetFrameRequest{Id: 1})
+
+	if code := status.Code(err); code != codes.Canceled {
+		t.Errorf("GetFrame() code = %v, want %v", code, codes.Canceled)
+	}
+}
+
+// startServer runs Start in the background and waits until it is listening.
+func startServer(t *testing.T, s *GrpcServer) {
+	t.Helper()
+
+	errs := make(chan error, 1)
+
+	go func() {
+		errs <- s.Start()
+	}()
+
+	select {
+	case <-s.Ready():
+	case err := <-errs:
+		t.Fatalf("Start() = %v", err)
+	}
+
+	t.Cleanup(func() {
+		s.Stop(context.Background())
+	})
+}
+
+func dialHealth(t *testing.T, target string) {
+	t.Helper()
+
+	conn, err := grpc.NewClient(target, grpc.WithTransportCredentials(insecure.NewCredentials()))
+
+	if err != nil {
+		t.Fatalf("NewClient(%s) = %v", target, err)
+	}
+
+	defer conn.Close()
+
+	res, err := healthpb.NewHealthClient(conn).Check(context.Background(), &healthpb.HealthCheckRequest{})
+
+	if err != nil || res.Status != healthpb.HealthCheckResponse_SERVING {
+		t.Errorf("Check() on %s = %v, %v, want SERVING", target, res, err)
+	}
+}
+
+func TestStartOnRandomPort(t *testing.T) {
+	s := NewGrpcServer("127.0.0.1:0", NewMemoryFrameStore())
+	startServer(t, s)
+
+	addr := s.Addr().(*net.TCPAddr)
+
+	if addr.Port == 0 {
+		t.Fatalf("Addr() = %v, want a resolved port", addr)
+	}
+
+	dialHealth(t, addr.String())
+}
+
+func TestListenOnTCPAndUnix(t *testing.T) {
+	socket := filepath.Join(t.TempDir(), "grpc.sock")
+	s := NewGrpcServer("127.0.0.1:0", NewMemoryFrameStore(), WithListenAddresses(unixPrefix+socket))
+	startServer(t, s)
+
+	addrs := s.Addrs()
+
+	if len(addrs) != 2 || addrs[0].Network() != "tcp" || addrs[1].Network() != "unix" {
+		t.Fatalf("Addrs() = %v, want a tcp and a unix address", addrs)
+	}
+
+	dialHealth(t, addrs[0].String())
+	dialHealth(t, "unix://"+socket)
+
+	if err := s.Stop(context.Background()); err != nil {
+		t.Fatalf("Stop() = %v", err)
+	}
+
+	if _, err := os.Stat(socket); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("socket still exists after Stop: %v", err)
+	}
+}
+
+func TestMaxConcurrentStreamsQueuesExcess(t *testing.T) {
+	const limit = 2
+	started := make(chan struct{}, limit+1)
+
+	s := NewGrpcServer("127.0.0.1:0", NewMemoryFrameStore(),
+		WithMaxConcurrentStreams(limit),
+		WithStreamInterceptors(func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			started <- struct{}{}
+			return handler(srv, ss)
+		}),
+	)
+	client := pb.NewFrameServiceClient(startTestServer(t, s))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Opening a stream blocks while the connection is at its limit, so each
+	// goroutine reports its cancel func once its stream is open.
+	opened := make(chan context.CancelFunc, limit+1)
+
+	for i := 0; i < limit+1; i++ {
+		go func() {
+			ctx, cancel := context.WithCancel(ctx)
+
+			if _, err := client.WatchFrames(ctx); err != nil {
+				cancel()
+				return
+			}
+
+			opened <- cancel
+		}()
+	}
+
+	var open []context.CancelFunc
+
+	for i := 0; i < limit; i++ {
+		select {
+		case cancel := <-opened:
+			open = append(open, cancel)
+			<-started
+		case <-time.After(time.Second):
+			t.Fatalf("only %d of %d streams opened", i, limit)
+		}
+	}
+
+	select {
+	case <-opened:
+		t.Fatalf("stream %d opened over the limit of %d", limit+1, limit)
+	case <-started:
+		t.Fatalf("stream %d started over the limit of %d", limit+1, limit)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// Closing a stream frees a slot for the queued one.
+	open[0]()
+
+	select {
+	case <-started:
+	case <-time.After(time.Second):
+		t.Error("queued stream did not start after another closed")
+	}
+}
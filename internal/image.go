@@ -0,0 +1,169 @@
+package internal
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"io"
+
+	"github.com/uandersonricardo/masterclass-go/pkg/pb"
+)
+
+var (
+	ErrUnsupportedEncoding  = errors.New("unsupported encoding")
+	ErrUnimplementedEncoder = errors.New("encoder not available")
+	ErrUnrecognizedImage    = errors.New("unrecognized image format")
+	ErrImageTooLarge        = errors.New("image too large")
+)
+
+// maxDecodePixels bounds the images decoded for scaling and conversion. A
+// highly compressible image can fit in a small message yet need gigabytes
+// once decoded; this budget caps it at about 128 MiB of RGBA pixels.
+const maxDecodePixels = 32 << 20
+
+var imageSignatures = []struct {
+	encoding string
+	magic    []byte
+}{
+	{"jpeg", []byte{0xff, 0xd8, 0xff}},
+	{"png", []byte("\x89PNG\r\n\x1a\n")},
+	{"gif", []byte("GIF87a")},
+	{"gif", []byte("GIF89a")},
+}
+
+// detectFrame sniffs the image format from its magic bytes and reads its
+// dimensions from the image header.
+func detectFrame(data []byte) (encoding string, w, h uint32, err error) {
+	for _, signature := range imageSignatures {
+		if bytes.HasPrefix(data, signature.magic) {
+			encoding = signature.encoding
+			break
+		}
+	}
+
+	if encoding == "" {
+		return "", 0, 0, ErrUnrecognizedImage
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+
+	if err != nil {
+		return "", 0, 0, fmt.Errorf("%w: %v", ErrUnrecognizedImage, err)
+	}
+
+	return encoding, uint32(config.Width), uint32(config.Height), nil
+}
+
+// fillFrameMetadata sets the encoding and dimensions of frames uploaded
+// without an encoding.
+func fillFrameMetadata(frame *pb.Frame) error {
+	if frame.Encoding != "" || len(frame.Data) == 0 {
+		return nil
+	}
+
+	encoding, w, h, err := detectFrame(frame.Data)
+
+	if err != nil {
+		return err
+	}
+
+	frame.Encoding = encoding
+	frame.Width = w
+	frame.Height = h
+
+	return nil
+}
+
+// decodeImage decodes data after checking from its header that it fits in
+// maxDecodePixels.
+func decodeImage(encoding string, data []byte) (image.Image, error) {
+	var decodeConfig func(io.Reader) (image.Config, error)
+
+	switch encoding {
+	case "jpeg":
+		decodeConfig = jpeg.DecodeConfig
+	case "png":
+		decodeConfig = png.DecodeConfig
+	case "gif":
+		decodeConfig = gif.DecodeConfig
+	default:
+		return nil, fmt.Errorf("%w %q", ErrUnsupportedEncoding, encoding)
+	}
+
+	config, err := decodeConfig(bytes.NewReader(data))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if int64(config.Width)*int64(config.Height) > maxDecodePixels {
+		return nil, fmt.Errorf("%w: %dx%d exceeds %d pixels", ErrImageTooLarge, config.Width, config.Height, maxDecodePixels)
+	}
+
+	r := bytes.NewReader(data)
+
+	switch encoding {
+	case "jpeg":
+		return jpeg.Decode(r)
+	case "png":
+		return png.Decode(r)
+	case "gif":
+		return gif.Decode(r)
+	default:
+		return nil, fmt.Errorf("%w %q", ErrUnsupportedEncoding, encoding)
+	}
+}
+
+func encodeImage(encoding string, img image.Image) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	var err error
+
+	switch encoding {
+	case "jpeg":
+		err = jpeg.Encode(buf, img, nil)
+	case "png":
+		err = png.Encode(buf, img)
+	case "gif":
+		err = gif.Encode(buf, img, nil)
+	default:
+		return nil, fmt.Errorf("%w for %q", ErrUnimplementedEncoder, encoding)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func convertFrame(frame *pb.Frame, encoding string) (*pb.Frame, error) {
+	if frame.Encoding == encoding {
+		return frame, nil
+	}
+
+	img, err := decodeImage(frame.Encoding, frame.Data)
+
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := encodeImage(encoding, img)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.Frame{
+		Id:          frame.Id,
+		Data:        data,
+		TimestampMs: frame.TimestampMs,
+		Width:       frame.Width,
+		Height:      frame.Height,
+		Encoding:    encoding,
+		Checksum:    frameChecksum(data),
+	}, nil
+}
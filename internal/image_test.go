@@ -0,0 +1,92 @@
+package internal
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"image"
+	"testing"
+
+	"github.com/uandersonricardo/masterclass-go/pkg/pb"
+)
+
+// pngHeader returns the signature and IHDR chunk of a PNG, which is enough
+// for DecodeConfig to report its dimensions without any pixel data.
+func pngHeader(width, height uint32) []byte {
+	ihdr := make([]byte, 13)
+	binary.BigEndian.PutUint32(ihdr[0:], width)
+	binary.BigEndian.PutUint32(ihdr[4:], height)
+	ihdr[8] = 8 // bit depth
+	ihdr[9] = 2 // truecolor
+
+	chunk := append([]byte("IHDR"), ihdr...)
+	buf := bytes.NewBufferString("\x89PNG\r\n\x1a\n")
+	binary.Write(buf, binary.BigEndian, uint32(len(ihdr)))
+	buf.Write(chunk)
+	binary.Write(buf, binary.BigEndian, crc32.ChecksumIEEE(chunk))
+
+	return buf.Bytes()
+}
+
+func TestDecodeImageTooLarge(t *testing.T) {
+	_, err := decodeImage("png", pngHeader(20000, 20000))
+
+	if !errors.Is(err, ErrImageTooLarge) {
+		t.Errorf("decodeImage() = %v, want %v", err, ErrImageTooLarge)
+	}
+}
+
+func TestConvertFrameTooLarge(t *testing.T) {
+	frame := &pb.Frame{Id: 1, Encoding: "png", Data: pngHeader(20000, 20000)}
+
+	_, err := convertFrame(frame, "jpeg")
+
+	if !errors.Is(err, ErrImageTooLarge) {
+		t.Errorf("convertFrame() = %v, want %v", err, ErrImageTooLarge)
+	}
+}
+
+func TestDetectFrame(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 6, 3))
+	samples := map[string][]byte{}
+
+	for _, encoding := range []string{"jpeg", "png", "gif"} {
+		data, err := encodeImage(encoding, img)
+
+		if err != nil {
+			t.Fatalf("encodeImage(%s) = %v", encoding, err)
+		}
+
+		samples[encoding] = data
+	}
+
+	for encoding, data := range samples {
+		t.Run(encoding, func(t *testing.T) {
+			got, w, h, err := detectFrame(data)
+
+			if err != nil || got != encoding || w != 6 || h != 3 {
+				t.Errorf("detectFrame() = %s %dx%d, %v, want %s 6x3", got, w, h, err, encoding)
+			}
+		})
+	}
+}
+
+func TestDetectFrameUnrecognized(t *testing.T) {
+	tests := map[string][]byte{
+		"empty":          nil,
+		"text":           []byte("not an image"),
+		"truncated png":  []byte("\x89PNG\r\n\x1a\n"),
+		"truncated jpeg": {0xff, 0xd8, 0xff},
+	}
+
+	for name, data := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, _, _, err := detectFrame(data)
+
+			if !errors.Is(err, ErrUnrecognizedImage) {
+				t.Errorf("detectFrame() = %v, want %v", err, ErrUnrecognizedImage)
+			}
+		})
+	}
+}
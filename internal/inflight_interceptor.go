@@ -0,0 +1,82 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type InflightLimiter struct {
+	sem      chan struct{}
+	block    bool
+	inflight atomic.Int64
+}
+
+// NewInflightLimiter admits up to n concurrent handlers. Calls over the limit
+// fail with codes.ResourceExhausted, or wait for a free slot when block is
+// set.
+func NewInflightLimiter(n int, block bool) (*InflightLimiter, error) {
+	if n <= 0 {
+		return nil, fmt.Errorf("inflight limit must be positive, got %d", n)
+	}
+
+	return &InflightLimiter{
+		sem:   make(chan struct{}, n),
+		block: block,
+	}, nil
+}
+
+func MaxInflightInterceptor(n int) (grpc.UnaryServerInterceptor, error) {
+	l, err := NewInflightLimiter(n, false)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return l.UnaryInterceptor(), nil
+}
+
+// Inflight returns the number of handlers currently running.
+func (l *InflightLimiter) Inflight() int64 {
+	return l.inflight.Load()
+}
+
+func (l *InflightLimiter) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := l.acquire(ctx); err != nil {
+			return nil, err
+		}
+
+		defer l.release()
+
+		return handler(ctx, req)
+	}
+}
+
+func (l *InflightLimiter) acquire(ctx context.Context) error {
+	if l.block {
+		select {
+		case l.sem <- struct{}{}:
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		}
+	} else {
+		select {
+		case l.sem <- struct{}{}:
+		default:
+			return status.Errorf(codes.ResourceExhausted, "too many requests in flight, limit is %d", cap(l.sem))
+		}
+	}
+
+	l.inflight.Add(1)
+	return nil
+}
+
+func (l *InflightLimiter) release() {
+	l.inflight.Add(-1)
+	<-l.sem
+}
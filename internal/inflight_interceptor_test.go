@@ -0,0 +1,98 @@
+package internal
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/uandersonricardo/masterclass-go/pkg/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// peakStore records the highest number of concurrent Get calls.
+type peakStore struct {
+	*MemoryFrameStore
+	current, peak atomic.Int64
+}
+
+func (p *peakStore) Get(ctx context.Context, id int32) (*pb.Frame, error) {
+	n := p.current.Add(1)
+	defer p.current.Add(-1)
+
+	for {
+		peak := p.peak.Load()
+
+		if n <= peak || p.peak.CompareAndSwap(peak, n) {
+			break
+		}
+	}
+
+	time.Sleep(time.Millisecond)
+	return p.MemoryFrameStore.Get(ctx, id)
+}
+
+func TestInflightLimiterStress(t *testing.T) {
+	const limit, calls = 10, 1000
+
+	for _, block := range []bool{false, true} {
+		limiter, err := NewInflightLimiter(limit, block)
+
+		if err != nil {
+			t.Fatalf("NewInflightLimiter() = %v", err)
+		}
+
+		store := &peakStore{MemoryFrameStore: NewMemoryFrameStore()}
+		s := NewGrpcServer("127.0.0.1:0", store, WithUnaryInterceptors(limiter.UnaryInterceptor()))
+		client := pb.NewFrameServiceClient(startTestServer(t, s))
+
+		var wg sync.WaitGroup
+		var rejected atomic.Int64
+
+		for i := 0; i < calls; i++ {
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+
+				_, err := client.GetFrame(context.Background(), &pb.GetFrameRequest{Id: 1})
+
+				switch status.Code(err) {
+				case codes.NotFound:
+				case codes.ResourceExhausted:
+					rejected.Add(1)
+				default:
+					t.Errorf("GetFrame() = %v", err)
+				}
+			}()
+		}
+
+		wg.Wait()
+
+		if peak := store.peak.Load(); peak > limit {
+			t.Errorf("block=%v: %d handlers ran at once, want at most %d", block, peak, limit)
+		}
+
+		if block && rejected.Load() != 0 {
+			t.Errorf("block=%v: %d calls rejected, want none", block, rejected.Load())
+		}
+
+		if !block && rejected.Load() == 0 {
+			t.Errorf("block=%v: no calls rejected over the limit", block)
+		}
+
+		if n := limiter.Inflight(); n != 0 {
+			t.Errorf("block=%v: Inflight() = %d after all calls finished", block, n)
+		}
+	}
+}
+
+func TestNewInflightLimiterInvalid(t *testing.T) {
+	for _, n := range []int{0, -1} {
+		if _, err := NewInflightLimiter(n, false); err == nil {
+			t.Errorf("NewInflightLimiter(%d) succeeded, want an error", n)
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"net"
+	"os"
+	"strings"
+	"syscall"
+)
+
+const unixPrefix = "unix://"
+
+var ErrAddressInUse = errors.New("address already in use")
+
+// AddressInUseError is returned when another process is already bound to the
+// address. It matches ErrAddressInUse with errors.Is and unwraps to the
+// original listen error.
+type AddressInUseError struct {
+	Address string
+	Err     error
+}
+
+func (e *AddressInUseError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *AddressInUseError) Unwrap() error {
+	return e.Err
+}
+
+func (e *AddressInUseError) Is(target error) bool {
+	return target == ErrAddressInUse
+}
+
+func parseAddress(address string) (network, addr string) {
+	if path, ok := strings.CutPrefix(address, unixPrefix); ok {
+		return "unix", path
+	}
+
+	return "tcp", address
+}
+
+// Listen listens on a TCP address or on a "unix://" socket path, replacing
+// a stale socket file. It returns an *AddressInUseError when the address is
+// taken.
+func Listen(address string) (net.Listener, error) {
+	network, addr := parseAddress(address)
+
+	if network == "unix" {
+		err := removeSocket(addr)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	lis, err := net.Listen(network, addr)
+
+	if errors.Is(err, syscall.EADDRINUSE) {
+		return nil, &AddressInUseError{Address: address, Err: err}
+	}
+
+	return lis, err
+}
+
+// listenAll listens on every address, closing the listeners already opened
+// if any of them fails.
+func listenAll(addresses []string) ([]net.Listener, error) {
+	listeners := make([]net.Listener, 0, len(addresses))
+
+	for _, address := range addresses {
+		lis, err := Listen(address)
+
+		if err != nil {
+			closeAll(listeners)
+			return nil, fmt.Errorf("failed to listen on %s: %w", address, err)
+		}
+
+		listeners = append(listeners, lis)
+	}
+
+	return listeners, nil
+}
+
+func closeAll(listeners []net.Listener) {
+	for _, lis := range listeners {
+		lis.Close()
+	}
+}
+
+func removeSocket(path string) error {
+	info, err := os.Lstat(path)
+
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if info.Mode()&fs.ModeSocket == 0 {
+		return fmt.Errorf("%s exists and is not a socket", path)
+	}
+
+	return os.Remove(path)
+}
@@ -0,0 +1,52 @@
+package internal
+
+import (
+	"errors"
+	"net"
+	"testing"
+)
+
+func TestListenAddressInUse(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer lis.Close()
+
+	_, err = Listen(lis.Addr().String())
+
+	if !errors.Is(err, ErrAddressInUse) {
+		t.Fatalf("Listen() = %v, want %v", err, ErrAddressInUse)
+	}
+
+	var inUse *AddressInUseError
+
+	if !errors.As(err, &inUse) || inUse.Address != lis.Addr().String() {
+		t.Errorf("Listen() = %#v, want an AddressInUseError for %s", err, lis.Addr())
+	}
+}
+
+func TestStartAddressInUse(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer lis.Close()
+
+	tests := map[string]*GrpcServer{
+		"grpc":    NewGrpcServer(lis.Addr().String(), NewMemoryFrameStore()),
+		"metrics": NewGrpcServer("127.0.0.1:0", NewMemoryFrameStore(), WithMetrics(lis.Addr().String())),
+	}
+
+	for name, s := range tests {
+		t.Run(name, func(t *testing.T) {
+			if err := s.Start(); !errors.Is(err, ErrAddressInUse) {
+				t.Errorf("Start() = %v, want %v", err, ErrAddressInUse)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package internal
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+type idGetter interface {
+	GetId() int32
+}
+
+func LoggingUnaryInterceptor(logger *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+		res, err := handler(ctx, req)
+
+		attrs := []any{
+			slog.String("method", info.FullMethod),
+			slog.Duration("duration", time.Since(start)),
+			slog.String("code", status.Code(err).String()),
+		}
+
+		if id := RequestIDFromContext(ctx); id != "" {
+			attrs = append(attrs, slog.String("request_id", id))
+		}
+
+		if r, ok := req.(idGetter); ok {
+			attrs = append(attrs, slog.Int("id", int(r.GetId())))
+		}
+
+		logger.InfoContext(ctx, "unary call", attrs...)
+		return res, err
+	}
+}
+
+func LoggingStreamInterceptor(logger *slog.Logger) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, ss)
+
+		attrs := []any{
+			slog.String("method", info.FullMethod),
+			slog.Duration("duration", time.Since(start)),
+			slog.String("code", status.Code(err).String()),
+		}
+
+		if id := RequestIDFromContext(ss.Context()); id != "" {
+			attrs = append(attrs, slog.String("request_id", id))
+		}
+
+		logger.InfoContext(ss.Context(), "stream call", attrs...)
+
+		return err
+	}
+}
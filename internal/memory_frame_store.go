@@ -0,0 +1,206 @@
+package internal
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/uandersonricardo/masterclass-go/pkg/pb"
+	"google.golang.org/protobuf/proto"
+)
+
+type MemoryFrameStoreOption func(*MemoryFrameStore)
+
+type MemoryFrameStore struct {
+	mu     sync.RWMutex
+	frames map[int32]memoryEntry
+
+	ttl       time.Duration
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+type memoryEntry struct {
+	frame     *pb.Frame
+	expiresAt time.Time
+}
+
+func (e memoryEntry) expired(now time.Time) bool {
+	return !e.expiresAt.IsZero() && !now.Before(e.expiresAt)
+}
+
+// WithTTL expires frames ttl after they are stored. Expired frames are treated
+// as missing and evicted by a background janitor until Close is called.
+func WithTTL(ttl time.Duration) MemoryFrameStoreOption {
+	return func(m *MemoryFrameStore) {
+		m.ttl = ttl
+	}
+}
+
+func NewMemoryFrameStore(opts ...MemoryFrameStoreOption) *MemoryFrameStore {
+	m := &MemoryFrameStore{
+		frames: make(map[int32]memoryEntry),
+		done:   make(chan struct{}),
+	}
+
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	if m.ttl > 0 {
+		go m.janitor(m.ttl)
+	}
+
+	return m
+}
+
+func (m *MemoryFrameStore) Get(ctx context.Context, id int32) (*pb.Frame, error) {
+	frame, _, err := m.getWithExpiry(ctx, id)
+	return frame, err
+}
+
+// getWithExpiry is Get that also returns when the frame expires, or the zero
+// time when it does not.
+func (m *MemoryFrameStore) getWithExpiry(ctx context.Context, id int32) (*pb.Frame, time.Time, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, time.Time{}, err
+	}
+
+	m.mu.RLock()
+	entry, ok := m.frames[id]
+	m.mu.RUnlock()
+
+	if !ok || entry.expired(time.Now()) {
+		return nil, time.Time{}, ErrFrameNotFound
+	}
+
+	return proto.Clone(entry.frame).(*pb.Frame), entry.expiresAt, nil
+}
+
+func (m *MemoryFrameStore) Put(ctx context.Context, frame *pb.Frame) error {
+	entry := m.newEntry(frame)
+
+	m.mu.Lock()
+	m.frames[frame.Id] = entry
+	m.mu.Unlock()
+
+	return nil
+}
+
+func (m *MemoryFrameStore) PutIfAbsent(ctx context.Context, frame *pb.Frame) error {
+	entry := m.newEntry(frame)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if existing, ok := m.frames[frame.Id]; ok && !existing.expired(time.Now()) {
+		return ErrFrameExists
+	}
+
+	m.frames[frame.Id] = entry
+	return nil
+}
+
+func (m *MemoryFrameStore) newEntry(frame *pb.Frame) memoryEntry {
+	entry := memoryEntry{
+		frame: proto.Clone(frame).(*pb.Frame),
+	}
+
+	if m.ttl > 0 {
+		entry.expiresAt = time.Now().Add(m.ttl)
+	}
+
+	return entry
+}
+
+func (m *MemoryFrameStore) Delete(ctx context.Context, id int32) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	entry, ok := m.frames[id]
+
+	if !ok {
+		return ErrFrameNotFound
+	}
+
+	delete(m.frames, id)
+
+	if entry.expired(time.Now()) {
+		return ErrFrameNotFound
+	}
+
+	return nil
+}
+
+func (m *MemoryFrameStore) List(ctx context.Context, afterID int32, limit int) ([]*pb.Frame, error) {
+	return m.list(afterID, limit, func(frame *pb.Frame) *pb.Frame {
+		return proto.Clone(frame).(*pb.Frame)
+	})
+}
+
+func (m *MemoryFrameStore) ListHeaders(ctx context.Context, afterID int32, limit int) ([]*pb.Frame, error) {
+	return m.list(afterID, limit, frameHeader)
+}
+
+func (m *MemoryFrameStore) list(afterID int32, limit int, copyFrame func(*pb.Frame) *pb.Frame) ([]*pb.Frame, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	now := time.Now()
+	ids := make([]int32, 0, len(m.frames))
+
+	for id, entry := range m.frames {
+		if id > afterID && !entry.expired(now) {
+			ids = append(ids, id)
+		}
+	}
+
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	if len(ids) > limit {
+		ids = ids[:limit]
+	}
+
+	frames := make([]*pb.Frame, 0, len(ids))
+
+	for _, id := range ids {
+		frames = append(frames, copyFrame(m.frames[id].frame))
+	}
+
+	return frames, nil
+}
+
+// Close stops the background janitor. It is safe to call more than once.
+func (m *MemoryFrameStore) Close() error {
+	m.closeOnce.Do(func() {
+		close(m.done)
+	})
+
+	return nil
+}
+
+func (m *MemoryFrameStore) janitor(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-m.done:
+			return
+		case now := <-ticker.C:
+			m.evictExpired(now)
+		}
+	}
+}
+
+func (m *MemoryFrameStore) evictExpired(now time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for id, entry := range m.frames {
+		if entry.expired(now) {
+			delete(m.frames, id)
+		}
+	}
+}
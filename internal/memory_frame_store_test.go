@@ -0,0 +1,90 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/uandersonricardo/masterclass-go/pkg/pb"
+)
+
+func TestMemoryFrameStoreLazyExpiry(t *testing.T) {
+	ctx := context.Background()
+
+	// Setting the TTL after construction skips the janitor, so only the lazy
+	// checks on read can hide the frame.
+	store := NewMemoryFrameStore()
+	store.ttl = 20 * time.Millisecond
+	store.Put(ctx, &pb.Frame{Id: 1})
+
+	if _, err := store.Get(ctx, 1); err != nil {
+		t.Fatalf("Get() before the TTL = %v", err)
+	}
+
+	time.Sleep(40 * time.Millisecond)
+
+	if _, err := store.Get(ctx, 1); !errors.Is(err, ErrFrameNotFound) {
+		t.Errorf("Get() after the TTL = %v, want %v", err, ErrFrameNotFound)
+	}
+
+	if frames, err := store.List(ctx, 0, 10); err != nil || len(frames) != 0 {
+		t.Errorf("List() after the TTL = %v, %v, want no frames", frames, err)
+	}
+
+	if err := store.Delete(ctx, 1); !errors.Is(err, ErrFrameNotFound) {
+		t.Errorf("Delete() after the TTL = %v, want %v", err, ErrFrameNotFound)
+	}
+}
+
+func TestMemoryFrameStoreJanitor(t *testing.T) {
+	store := NewMemoryFrameStore(WithTTL(10 * time.Millisecond))
+	defer store.Close()
+
+	store.Put(context.Background(), &pb.Frame{Id: 1})
+
+	deadline := time.Now().Add(time.Second)
+
+	for {
+		store.mu.RLock()
+		n := len(store.frames)
+		store.mu.RUnlock()
+
+		if n == 0 {
+			return
+		}
+
+		if time.Now().After(deadline) {
+			t.Fatalf("janitor did not evict the expired frame")
+		}
+
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestMemoryFrameStoreCloseStopsJanitor(t *testing.T) {
+	before := runtime.NumGoroutine()
+	stores := make([]*MemoryFrameStore, 10)
+
+	for i := range stores {
+		stores[i] = NewMemoryFrameStore(WithTTL(time.Hour))
+	}
+
+	for _, store := range stores {
+		store.Close()
+
+		// Closing twice is safe.
+		store.Close()
+	}
+
+	deadline := time.Now().Add(time.Second)
+
+	for runtime.NumGoroutine() > before {
+		if time.Now().After(deadline) {
+			t.Fatalf("%d goroutines still running after Close, had %d before", runtime.NumGoroutine(), before)
+		}
+
+		time.Sleep(5 * time.Millisecond)
+	}
+}
@@ -0,0 +1,302 @@
+package internal
+
+import (
+	"crypto/tls"
+	"errors"
+	"log/slog"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/keepalive"
+)
+
+const (
+	// Same as the gRPC default: 4 MiB per received message.
+	defaultMaxRecvMsgSize = 4 * 1024 * 1024
+	// Streams a single connection may have open at once. gRPC has no limit by
+	// default; 100 is the minimum HTTP/2 recommends peers allow.
+	defaultMaxConcurrentStreams = 100
+	// Number of store lookups a single BatchGetFrames call runs at once.
+	defaultBatchConcurrency = 8
+)
+
+// Idle connections are closed after 5 minutes and every connection is
+// recycled after 30 minutes (plus 5 minutes to finish in-flight RPCs), so
+// long-lived clients get rebalanced across backends.
+var defaultKeepaliveParams = keepalive.ServerParameters{
+	MaxConnectionIdle:     5 * time.Minute,
+	MaxConnectionAge:      30 * time.Minute,
+	MaxConnectionAgeGrace: 5 * time.Minute,
+	Time:                  2 * time.Hour,
+	Timeout:               20 * time.Second,
+}
+
+// The gRPC default only tolerates a ping every 5 minutes and closes the
+// connection otherwise. Clients are allowed to ping every 10 seconds, even
+// without active streams.
+var defaultKeepalivePolicy = keepalive.EnforcementPolicy{
+	MinTime:             10 * time.Second,
+	PermitWithoutStream: true,
+}
+
+type Option func(*options)
+
+type options struct {
+	maxRecvMsgSize       int
+	maxConcurrentStreams uint32
+	keepaliveParams      keepalive.ServerParameters
+	keepalivePolicy      keepalive.EnforcementPolicy
+	serverOptions        []grpc.ServerOption
+	unaryInterceptors    []grpc.UnaryServerInterceptor
+	streamInterceptors   []grpc.StreamServerInterceptor
+	recovery             bool
+	recoveryHandler      RecoveryHandlerFunc
+	reflection           bool
+	batchConcurrency     int
+	compression          string
+	verifyChecksums      bool
+	extraAddresses       []string
+	logger               *slog.Logger
+	metrics              *metrics
+	metricsAddress       string
+	tracerProvider       trace.TracerProvider
+	drain                *drainGate
+
+	tlsConfig    *tls.Config
+	certFile     string
+	keyFile      string
+	clientCAFile string
+}
+
+func defaultOptions() *options {
+	return &options{
+		maxRecvMsgSize:       defaultMaxRecvMsgSize,
+		maxConcurrentStreams: defaultMaxConcurrentStreams,
+		keepaliveParams:      defaultKeepaliveParams,
+		keepalivePolicy:      defaultKeepalivePolicy,
+		batchConcurrency:     defaultBatchConcurrency,
+		verifyChecksums:      true,
+		logger:               slog.New(discardHandler{}),
+		drain:                &drainGate{},
+	}
+}
+
+func WithMaxRecvMsgSize(size int) Option {
+	return func(o *options) {
+		o.maxRecvMsgSize = size
+	}
+}
+
+// WithMaxConcurrentStreams limits the streams a single connection may have
+// open at once, 100 by default. Streams over the limit wait until others
+// finish. Zero would stall every RPC and is rejected by Start.
+func WithMaxConcurrentStreams(n uint32) Option {
+	return func(o *options) {
+		o.maxConcurrentStreams = n
+	}
+}
+
+func WithKeepaliveParams(params keepalive.ServerParameters) Option {
+	return func(o *options) {
+		o.keepaliveParams = params
+	}
+}
+
+func WithKeepaliveEnforcementPolicy(policy keepalive.EnforcementPolicy) Option {
+	return func(o *options) {
+		o.keepalivePolicy = policy
+	}
+}
+
+func WithBatchConcurrency(n int) Option {
+	return func(o *options) {
+		o.batchConcurrency = n
+	}
+}
+
+// WithCompression compresses responses with the named compressor, such as
+// "gzip", for clients that advertise support for it. Requests are always
+// decompressed with whatever registered compressor the client used.
+// Compression trades CPU for bandwidth: gzip typically shrinks raw frames
+// several times over but does little for data that is already JPEG or PNG.
+func WithCompression(name string) Option {
+	return func(o *options) {
+		o.compression = name
+	}
+}
+
+// WithChecksumVerification controls whether checksums are filled in when
+// frames are stored and verified when they are read. It is enabled by
+// default.
+func WithChecksumVerification(enabled bool) Option {
+	return func(o *options) {
+		o.verifyChecksums = enabled
+	}
+}
+
+func WithServerOptions(opts ...grpc.ServerOption) Option {
+	return func(o *options) {
+		o.serverOptions = append(o.serverOptions, opts...)
+	}
+}
+
+// WithUnaryInterceptors adds interceptors in declared order, see
+// ChainUnaryInterceptors. Repeated calls append to the chain. They always run
+// after the built-in request id, logging, metrics, draining and compression
+// interceptors and before request validation; use WithRecovery to recover
+// panics in the built-in ones too.
+func WithUnaryInterceptors(interceptors ...grpc.UnaryServerInterceptor) Option {
+	return func(o *options) {
+		o.unaryInterceptors = append(o.unaryInterceptors, interceptors...)
+	}
+}
+
+// WithStreamInterceptors adds interceptors in declared order, see
+// ChainStreamInterceptors. Repeated calls append to the chain, after the
+// built-in interceptors like WithUnaryInterceptors.
+func WithStreamInterceptors(interceptors ...grpc.StreamServerInterceptor) Option {
+	return func(o *options) {
+		o.streamInterceptors = append(o.streamInterceptors, interceptors...)
+	}
+}
+
+// WithRecovery recovers from panics in handlers and in every interceptor,
+// built-in ones included, converting them to errors with fn. A nil fn
+// returns codes.Internal.
+func WithRecovery(fn RecoveryHandlerFunc) Option {
+	return func(o *options) {
+		o.recovery = true
+		o.recoveryHandler = fn
+	}
+}
+
+// WithLogger sets the logger used for startup and shutdown messages and for
+// logging every RPC. Nothing is logged by default.
+func WithLogger(logger *slog.Logger) Option {
+	return func(o *options) {
+		o.logger = logger
+	}
+}
+
+// WithListenAddresses also serves on the given addresses, which may be TCP
+// addresses or unix:// socket paths.
+func WithListenAddresses(addresses ...string) Option {
+	return func(o *options) {
+		o.extraAddresses = append(o.extraAddresses, addresses...)
+	}
+}
+
+// WithReflection registers the server reflection service, which exposes the
+// schema of every registered service. Keep it disabled in production.
+func WithReflection() Option {
+	return func(o *options) {
+		o.reflection = true
+	}
+}
+
+// WithMetrics collects Prometheus metrics for every RPC. When address is not
+// empty, Start also serves them over HTTP on /metrics at that address.
+func WithMetrics(address string) Option {
+	return func(o *options) {
+		o.metrics = newMetrics()
+		o.metricsAddress = address
+	}
+}
+
+// WithTracing records a span for every RPC using the given provider. Tracing
+// is disabled when no provider is configured.
+func WithTracing(tp trace.TracerProvider) Option {
+	return func(o *options) {
+		o.tracerProvider = tp
+	}
+}
+
+// WithTLS serves over TLS using the given PEM encoded certificate and key.
+// Loading errors are reported by Start.
+func WithTLS(certFile, keyFile string) Option {
+	return func(o *options) {
+		o.certFile = certFile
+		o.keyFile = keyFile
+	}
+}
+
+func WithTLSConfig(config *tls.Config) Option {
+	return func(o *options) {
+		o.tlsConfig = config
+	}
+}
+
+// WithClientCA enables mutual TLS, requiring client certificates signed by
+// one of the CAs in the given PEM file.
+func WithClientCA(caFile string) Option {
+	return func(o *options) {
+		o.clientCAFile = caFile
+	}
+}
+
+func (o *options) grpcServerOptions() ([]grpc.ServerOption, error) {
+	if o.maxConcurrentStreams == 0 {
+		return nil, errors.New("max concurrent streams must be positive")
+	}
+
+	opts := []grpc.ServerOption{
+		grpc.MaxRecvMsgSize(o.maxRecvMsgSize),
+		grpc.MaxConcurrentStreams(o.maxConcurrentStreams),
+		grpc.KeepaliveParams(o.keepaliveParams),
+		grpc.KeepaliveEnforcementPolicy(o.keepalivePolicy),
+	}
+
+	if o.tracerProvider != nil {
+		opts = append(opts, grpc.StatsHandler(tracingStatsHandler(o.tracerProvider)))
+	}
+
+	var unaryInterceptors []grpc.UnaryServerInterceptor
+	var streamInterceptors []grpc.StreamServerInterceptor
+
+	if o.recovery {
+		unaryInterceptors = append(unaryInterceptors, RecoveryUnaryInterceptor(o.logger, o.recoveryHandler))
+		streamInterceptors = append(streamInterceptors, RecoveryStreamInterceptor(o.logger, o.recoveryHandler))
+	}
+
+	unaryInterceptors = append(unaryInterceptors, RequestIDUnaryInterceptor(), LoggingUnaryInterceptor(o.logger))
+	streamInterceptors = append(streamInterceptors, RequestIDStreamInterceptor(), LoggingStreamInterceptor(o.logger))
+
+	if o.metrics != nil {
+		unaryInterceptors = append(unaryInterceptors, o.metrics.unaryInterceptor())
+		streamInterceptors = append(streamInterceptors, o.metrics.streamInterceptor())
+	}
+
+	unaryInterceptors = append(unaryInterceptors, o.drain.unaryInterceptor())
+	streamInterceptors = append(streamInterceptors, o.drain.streamInterceptor())
+
+	if o.compression != "" {
+		if err := validateCompressor(o.compression); err != nil {
+			return nil, err
+		}
+
+		unaryInterceptors = append(unaryInterceptors, compressionUnaryInterceptor(o.compression))
+		streamInterceptors = append(streamInterceptors, compressionStreamInterceptor(o.compression))
+	}
+
+	unaryInterceptors = append(unaryInterceptors, o.unaryInterceptors...)
+	unaryInterceptors = append(unaryInterceptors, ValidationUnaryInterceptor())
+	streamInterceptors = append(streamInterceptors, o.streamInterceptors...)
+
+	opts = append(opts,
+		grpc.ChainUnaryInterceptor(ChainUnaryInterceptors(unaryInterceptors...)),
+		grpc.ChainStreamInterceptor(ChainStreamInterceptors(streamInterceptors...)),
+	)
+
+	creds, err := o.transportCredentials()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if creds != nil {
+		opts = append(opts, grpc.Creds(creds))
+	}
+
+	return append(opts, o.serverOptions...), nil
+}
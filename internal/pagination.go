@@ -0,0 +1,48 @@
+package internal
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 1000
+)
+
+func clampPageSize(size int32) int {
+	if size <= 0 {
+		return defaultPageSize
+	}
+
+	if size > maxPageSize {
+		return maxPageSize
+	}
+
+	return int(size)
+}
+
+func encodePageToken(lastID int32) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(int64(lastID), 10)))
+}
+
+func decodePageToken(token string) (int32, error) {
+	if token == "" {
+		return 0, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+
+	if err != nil {
+		return 0, fmt.Errorf("malformed page token: %w", err)
+	}
+
+	id, err := strconv.ParseInt(string(raw), 10, 32)
+
+	if err != nil {
+		return 0, fmt.Errorf("malformed page token: %w", err)
+	}
+
+	return int32(id), nil
+}
@@ -0,0 +1,148 @@
+package internal
+
+import (
+	"context"
+	"math"
+	"net"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// Limiters unused for this long are dropped, so PerPeer does not keep one
+// per client forever.
+const limiterIdleTimeout = 5 * time.Minute
+
+// RateLimit is a token bucket. The zero value does not limit.
+type RateLimit struct {
+	// Requests per second refilled into the bucket.
+	Rate float64
+	// Maximum number of requests allowed at once. Zero allows one
+	// second's worth of Rate, and at least one.
+	Burst int
+}
+
+func (r RateLimit) burst() int {
+	if r.Burst > 0 || r.Rate <= 0 {
+		return r.Burst
+	}
+
+	return max(1, int(math.Ceil(r.Rate)))
+}
+
+type RateLimitConfig struct {
+	Global RateLimit
+	// Methods overrides Global for the given full method names, such as
+	// "/masterclass.go.FrameService/GetFrame".
+	Methods map[string]RateLimit
+	// PerPeer gives every client host its own buckets instead of sharing
+	// them across all callers.
+	PerPeer bool
+}
+
+type rateLimiter struct {
+	config RateLimitConfig
+	now    func() time.Time
+
+	mu        sync.Mutex
+	limiters  map[string]*limiterEntry
+	lastSweep time.Time
+}
+
+type limiterEntry struct {
+	limiter  *rate.Limiter
+	lastUsed time.Time
+}
+
+// RateLimitUnaryInterceptor rejects calls over the configured limits with
+// codes.ResourceExhausted. Health checks are never limited.
+func RateLimitUnaryInterceptor(config RateLimitConfig) grpc.UnaryServerInterceptor {
+	l := newRateLimiter(config)
+
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if !l.allow(ctx, info.FullMethod) {
+			return nil, status.Errorf(codes.ResourceExhausted, "rate limit exceeded for %s", info.FullMethod)
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+func newRateLimiter(config RateLimitConfig) *rateLimiter {
+	return &rateLimiter{
+		config:   config,
+		now:      time.Now,
+		limiters: make(map[string]*limiterEntry),
+	}
+}
+
+func (l *rateLimiter) allow(ctx context.Context, method string) bool {
+	if isHealthMethod(method) {
+		return true
+	}
+
+	limit, ok := l.config.Methods[method]
+	key := method
+
+	if !ok {
+		limit = l.config.Global
+		key = ""
+	}
+
+	if limit == (RateLimit{}) {
+		return true
+	}
+
+	if l.config.PerPeer {
+		if p, ok := peer.FromContext(ctx); ok {
+			key += "|" + peerHost(p.Addr)
+		}
+	}
+
+	now := l.now()
+
+	l.mu.Lock()
+	l.sweep(now)
+	entry, ok := l.limiters[key]
+
+	if !ok {
+		entry = &limiterEntry{limiter: rate.NewLimiter(rate.Limit(limit.Rate), limit.burst())}
+		l.limiters[key] = entry
+	}
+
+	entry.lastUsed = now
+	l.mu.Unlock()
+
+	return entry.limiter.AllowN(now, 1)
+}
+
+// sweep drops idle limiters, at most once per limiterIdleTimeout. l.mu must
+// be held.
+func (l *rateLimiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < limiterIdleTimeout {
+		return
+	}
+
+	for key, entry := range l.limiters {
+		if now.Sub(entry.lastUsed) >= limiterIdleTimeout {
+			delete(l.limiters, key)
+		}
+	}
+
+	l.lastSweep = now
+}
+
+func peerHost(addr net.Addr) string {
+	host, _, err := net.SplitHostPort(addr.String())
+
+	if err != nil {
+		return addr.String()
+	}
+
+	return host
+}
@@ -0,0 +1,100 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/peer"
+)
+
+const getFrameMethod = "/masterclass.go.FrameService/GetFrame"
+
+func peerContext(host string) context.Context {
+	return peer.NewContext(context.Background(), &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.ParseIP(host), Port: 40000},
+	})
+}
+
+func TestRateLimiterMethodOverride(t *testing.T) {
+	l := newRateLimiter(RateLimitConfig{
+		Methods: map[string]RateLimit{getFrameMethod: {Rate: 1, Burst: 1}},
+	})
+	ctx := context.Background()
+
+	if !l.allow(ctx, getFrameMethod) {
+		t.Error("first GetFrame rejected")
+	}
+
+	if l.allow(ctx, getFrameMethod) {
+		t.Error("second GetFrame allowed over a burst of 1")
+	}
+
+	// A zero Global does not limit methods without an override.
+	for i := 0; i < 10; i++ {
+		if !l.allow(ctx, "/masterclass.go.FrameService/ListFrames") {
+			t.Fatal("ListFrames rejected with a zero global limit")
+		}
+	}
+}
+
+func TestRateLimiterDefaultBurst(t *testing.T) {
+	tests := []struct {
+		rate float64
+		want int
+	}{
+		{rate: 100, want: 100},
+		{rate: 2.5, want: 3},
+		{rate: 0.5, want: 1},
+	}
+
+	for _, tt := range tests {
+		now := time.Now()
+		l := newRateLimiter(RateLimitConfig{Global: RateLimit{Rate: tt.rate}})
+		l.now = func() time.Time { return now }
+		allowed := 0
+
+		for i := 0; i < tt.want+1; i++ {
+			if l.allow(context.Background(), getFrameMethod) {
+				allowed++
+			}
+		}
+
+		if allowed != tt.want {
+			t.Errorf("Rate %v with no Burst allowed %d calls at once, want %d", tt.rate, allowed, tt.want)
+		}
+	}
+}
+
+func TestRateLimiterExemptsHealth(t *testing.T) {
+	l := newRateLimiter(RateLimitConfig{Global: RateLimit{Rate: 1, Burst: 1}})
+
+	for i := 0; i < 10; i++ {
+		if !l.allow(context.Background(), "/grpc.health.v1.Health/Check") {
+			t.Fatal("health check rejected")
+		}
+	}
+}
+
+func TestRateLimiterEvictsIdlePeers(t *testing.T) {
+	now := time.Now()
+	l := newRateLimiter(RateLimitConfig{Global: RateLimit{Rate: 1, Burst: 1}, PerPeer: true})
+	l.now = func() time.Time { return now }
+
+	for i := 0; i < 100; i++ {
+		l.allow(peerContext(fmt.Sprintf("10.0.0.%d", i)), getFrameMethod)
+	}
+
+	if got := len(l.limiters); got != 100 {
+		t.Fatalf("limiters = %d, want 100", got)
+	}
+
+	now = now.Add(limiterIdleTimeout)
+	l.allow(peerContext("10.0.1.1"), getFrameMethod)
+
+	if got := len(l.limiters); got != 1 {
+		t.Errorf("limiters after idle timeout = %d, want 1", got)
+	}
+}
@@ -0,0 +1,53 @@
+package internal
+
+import (
+	"context"
+	"log/slog"
+	"runtime/debug"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RecoveryHandlerFunc converts a recovered panic value into the error
+// returned to the caller. A nil handler returns codes.Internal.
+type RecoveryHandlerFunc func(p any) error
+
+func RecoveryUnaryInterceptor(logger *slog.Logger, fn RecoveryHandlerFunc) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (res any, err error) {
+		defer func() {
+			if p := recover(); p != nil {
+				err = recoverPanic(ctx, logger, fn, info.FullMethod, p)
+			}
+		}()
+
+		return handler(ctx, req)
+	}
+}
+
+func RecoveryStreamInterceptor(logger *slog.Logger, fn RecoveryHandlerFunc) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		defer func() {
+			if p := recover(); p != nil {
+				err = recoverPanic(ss.Context(), logger, fn, info.FullMethod, p)
+			}
+		}()
+
+		return handler(srv, ss)
+	}
+}
+
+func recoverPanic(ctx context.Context, logger *slog.Logger, fn RecoveryHandlerFunc, method string, p any) error {
+	logger.ErrorContext(ctx, "recovered from panic",
+		slog.String("method", method),
+		slog.Any("panic", p),
+		slog.String("stack", string(debug.Stack())),
+	)
+
+	if fn != nil {
+		return fn(p)
+	}
+
+	return status.Error(codes.Internal, "internal error")
+}
@@ -0,0 +1,84 @@
+package internal
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/uandersonricardo/masterclass-go/pkg/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// panicStore panics on every Get and List, so GetFrame and StreamFrames
+// panic inside their handlers.
+type panicStore struct {
+	*MemoryFrameStore
+}
+
+func (panicStore) Get(ctx context.Context, id int32) (*pb.Frame, error) {
+	panic("boom")
+}
+
+func (panicStore) List(ctx context.Context, afterID int32, limit int) ([]*pb.Frame, error) {
+	panic("boom")
+}
+
+func TestRecoveryKeepsServerUp(t *testing.T) {
+	logger := slog.New(discardHandler{})
+	s := NewGrpcServer("127.0.0.1:0", panicStore{NewMemoryFrameStore()},
+		WithUnaryInterceptors(RecoveryUnaryInterceptor(logger, nil)),
+	)
+	client := pb.NewFrameServiceClient(startTestServer(t, s))
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		_, err := client.GetFrame(ctx, &pb.GetFrameRequest{Id: 1})
+
+		if code := status.Code(err); code != codes.Internal {
+			t.Fatalf("GetFrame() code = %v, want %v", code, codes.Internal)
+		}
+	}
+
+	if _, err := client.ListFrames(ctx, &pb.ListFramesRequest{}); err != nil {
+		t.Errorf("ListFrames() after panics = %v", err)
+	}
+}
+
+func TestRecoveryHandlerFunc(t *testing.T) {
+	logger := slog.New(discardHandler{})
+	s := NewGrpcServer("127.0.0.1:0", panicStore{NewMemoryFrameStore()},
+		WithUnaryInterceptors(RecoveryUnaryInterceptor(logger, func(p any) error {
+			return status.Errorf(codes.Unavailable, "recovered: %v", p)
+		})),
+	)
+	client := pb.NewFrameServiceClient(startTestServer(t, s))
+
+	_, err := client.GetFrame(context.Background(), &pb.GetFrameRequest{Id: 1})
+
+	if st := status.Convert(err); st.Code() != codes.Unavailable || st.Message() != "recovered: boom" {
+		t.Errorf("GetFrame() = %v, want Unavailable recovered: boom", err)
+	}
+}
+
+func TestWithRecovery(t *testing.T) {
+	s := NewGrpcServer("127.0.0.1:0", panicStore{NewMemoryFrameStore()}, WithRecovery(nil))
+	client := pb.NewFrameServiceClient(startTestServer(t, s))
+	ctx := context.Background()
+
+	_, err := client.GetFrame(ctx, &pb.GetFrameRequest{Id: 1})
+
+	if code := status.Code(err); code != codes.Internal {
+		t.Fatalf("GetFrame() code = %v, want %v", code, codes.Internal)
+	}
+
+	stream, err := client.StreamFrames(ctx, &pb.StreamFramesRequest{StartId: 1, EndId: 1})
+
+	if err != nil {
+		t.Fatalf("StreamFrames() = %v", err)
+	}
+
+	if _, err := stream.Recv(); status.Code(err) != codes.Internal {
+		t.Errorf("StreamFrames() Recv code = %v, want %v", status.Code(err), codes.Internal)
+	}
+}
@@ -0,0 +1,62 @@
+package internal
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const requestIDKey = "x-request-id"
+
+type requestIDContextKey struct{}
+
+func RequestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDContextKey{}).(string)
+	return id
+}
+
+// withRequestID reuses the caller's x-request-id, or generates one, and
+// stores it in the context.
+func withRequestID(ctx context.Context) (context.Context, string) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	id := ""
+
+	if values := md.Get(requestIDKey); len(values) > 0 && values[0] != "" {
+		id = values[0]
+	} else {
+		id = uuid.NewString()
+	}
+
+	return context.WithValue(ctx, requestIDContextKey{}, id), id
+}
+
+// RequestIDUnaryInterceptor tags every call with a request id and echoes it
+// back to the caller in the x-request-id trailer.
+func RequestIDUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, id := withRequestID(ctx)
+		grpc.SetTrailer(ctx, metadata.Pairs(requestIDKey, id))
+
+		return handler(ctx, req)
+	}
+}
+
+func RequestIDStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, id := withRequestID(ss.Context())
+		ss.SetTrailer(metadata.Pairs(requestIDKey, id))
+
+		return handler(srv, &contextServerStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+type contextServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextServerStream) Context() context.Context {
+	return s.ctx
+}
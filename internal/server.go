@@ -1,5 +1,8 @@
 package internal
 
+import "context"
+
 type Server interface {
 	Start() error
+	Stop(ctx context.Context) error
 }
@@ -0,0 +1,84 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	errorDomain = "masterclass.go"
+
+	reasonFrameNotFound  = "FRAME_NOT_FOUND"
+	reasonServerDraining = "SERVER_DRAINING"
+)
+
+func newNotFoundStatus(id int32) error {
+	st := status.Newf(codes.NotFound, "frame %d not found", id)
+
+	detailed, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: reasonFrameNotFound,
+		Domain: errorDomain,
+		Metadata: map[string]string{
+			"id": strconv.Itoa(int(id)),
+		},
+	})
+
+	if err != nil {
+		return st.Err()
+	}
+
+	return detailed.Err()
+}
+
+// newDrainingStatus tells clients the call was refused before it ran, so it
+// is safe to retry it against another server.
+func newDrainingStatus() error {
+	st := status.New(codes.Unavailable, "server is draining")
+
+	detailed, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: reasonServerDraining,
+		Domain: errorDomain,
+	})
+
+	if err != nil {
+		return st.Err()
+	}
+
+	return detailed.Err()
+}
+
+// storeStatus converts an error from reading frame id out of the store into
+// the status returned to the caller.
+func storeStatus(ctx context.Context, err error, id int32) error {
+	if ctx.Err() != nil {
+		return status.FromContextError(ctx.Err()).Err()
+	}
+
+	if errors.Is(err, ErrFrameNotFound) {
+		return newNotFoundStatus(id)
+	}
+
+	if errors.Is(err, ErrChecksumMismatch) {
+		return status.Error(codes.DataLoss, err.Error())
+	}
+
+	return status.Errorf(codes.Internal, "failed to get frame %d: %v", id, err)
+}
+
+// listStatus is storeStatus for errors from FrameStore.List.
+func listStatus(ctx context.Context, err error) error {
+	if ctx.Err() != nil {
+		return status.FromContextError(ctx.Err()).Err()
+	}
+
+	if errors.Is(err, ErrChecksumMismatch) {
+		return status.Error(codes.DataLoss, err.Error())
+	}
+
+	return status.Errorf(codes.Internal, "failed to list frames: %v", err)
+}
@@ -0,0 +1,97 @@
+package internal
+
+import (
+	"bytes"
+	"image"
+	"image/jpeg"
+
+	"github.com/uandersonricardo/masterclass-go/pkg/pb"
+)
+
+// thumbnail downscales frame to fit within maxWidth x maxHeight, keeping its
+// aspect ratio, and re-encodes it as JPEG. A zero bound is unconstrained.
+// JPEG frames that already fit are returned untouched; others are re-encoded
+// at their original size.
+func thumbnail(frame *pb.Frame, maxWidth, maxHeight uint32) (*pb.Frame, error) {
+	src, err := decodeImage(frame.Encoding, frame.Data)
+
+	if err != nil {
+		return nil, err
+	}
+
+	width, height := fitWithin(src.Bounds().Dx(), src.Bounds().Dy(), int(maxWidth), int(maxHeight))
+	fits := width == src.Bounds().Dx() && height == src.Bounds().Dy()
+
+	if fits && frame.Encoding == "jpeg" {
+		return frame, nil
+	}
+
+	if !fits {
+		src = resize(src, width, height)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := jpeg.Encode(buf, src, nil); err != nil {
+		return nil, err
+	}
+
+	return &pb.Frame{
+		Id:          frame.Id,
+		Data:        buf.Bytes(),
+		TimestampMs: frame.TimestampMs,
+		Width:       uint32(width),
+		Height:      uint32(height),
+		Encoding:    "jpeg",
+		Checksum:    frameChecksum(buf.Bytes()),
+	}, nil
+}
+
+func fitWithin(width, height, maxWidth, maxHeight int) (int, int) {
+	scale := 1.0
+
+	if maxWidth > 0 && width > maxWidth {
+		scale = min(scale, float64(maxWidth)/float64(width))
+	}
+
+	if maxHeight > 0 && height > maxHeight {
+		scale = min(scale, float64(maxHeight)/float64(height))
+	}
+
+	return max(1, int(float64(width)*scale)), max(1, int(float64(height)*scale))
+}
+
+// resize averages every source pixel that falls inside each destination
+// pixel, which gives a smooth result when shrinking.
+func resize(src image.Image, width, height int) image.Image {
+	bounds := src.Bounds()
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+
+	for y := 0; y < height; y++ {
+		y0 := bounds.Min.Y + y*bounds.Dy()/height
+		y1 := max(y0+1, bounds.Min.Y+(y+1)*bounds.Dy()/height)
+
+		for x := 0; x < width; x++ {
+			x0 := bounds.Min.X + x*bounds.Dx()/width
+			x1 := max(x0+1, bounds.Min.X+(x+1)*bounds.Dx()/width)
+
+			var r, g, b, a, n uint64
+
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					sr, sg, sb, sa := src.At(sx, sy).RGBA()
+					r, g, b, a = r+uint64(sr), g+uint64(sg), b+uint64(sb), a+uint64(sa)
+					n++
+				}
+			}
+
+			i := dst.PixOffset(x, y)
+			dst.Pix[i+0] = uint8(r / n >> 8)
+			dst.Pix[i+1] = uint8(g / n >> 8)
+			dst.Pix[i+2] = uint8(b / n >> 8)
+			dst.Pix[i+3] = uint8(a / n >> 8)
+		}
+	}
+
+	return dst
+}
@@ -0,0 +1,56 @@
+package internal
+
+import (
+	"context"
+	"testing"
+
+	"github.com/uandersonricardo/masterclass-go/pkg/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestThumbnail(t *testing.T) {
+	tests := []struct {
+		name                string
+		maxWidth, maxHeight uint32
+		width, height       uint32
+	}{
+		{"width bound", 2, 0, 2, 1},
+		{"height bound", 0, 1, 2, 1},
+		{"already fits", 100, 100, 8, 4},
+	}
+
+	frame := &pb.Frame{Id: 1, Encoding: "png", Width: 8, Height: 4, Data: testPNG(t, 8, 4)}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			thumb, err := thumbnail(frame, tt.maxWidth, tt.maxHeight)
+
+			if err != nil {
+				t.Fatalf("thumbnail() = %v", err)
+			}
+
+			if thumb.Encoding != "jpeg" || thumb.Width != tt.width || thumb.Height != tt.height {
+				t.Errorf("thumbnail() = %s %dx%d, want jpeg %dx%d", thumb.Encoding, thumb.Width, thumb.Height, tt.width, tt.height)
+			}
+		})
+	}
+}
+
+func TestGetFrameThumbnailTooLarge(t *testing.T) {
+	client := newTestClient(t)
+
+	_, err := client.PutFrame(context.Background(), &pb.PutFrameRequest{
+		Frame: &pb.Frame{Id: 1, Data: pngHeader(20000, 20000)},
+	})
+
+	if err != nil {
+		t.Fatalf("PutFrame() = %v", err)
+	}
+
+	_, err = client.GetFrame(context.Background(), &pb.GetFrameRequest{Id: 1, MaxWidth: 1})
+
+	if code := status.Code(err); code != codes.InvalidArgument {
+		t.Errorf("GetFrame() code = %v, want %v", code, codes.InvalidArgument)
+	}
+}
@@ -0,0 +1,39 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TimeoutUnaryInterceptor bounds every call by d, or by the entry in
+// overrides for its full method name. A tighter deadline set by the caller
+// is always kept.
+func TimeoutUnaryInterceptor(d time.Duration, overrides map[string]time.Duration) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		timeout := d
+
+		if override, ok := overrides[info.FullMethod]; ok {
+			timeout = override
+		}
+
+		if timeout <= 0 {
+			return handler(ctx, req)
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+
+		res, err := handler(ctx, req)
+
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return nil, status.Errorf(codes.DeadlineExceeded, "%s exceeded its deadline", info.FullMethod)
+		}
+
+		return res, err
+	}
+}
@@ -0,0 +1,51 @@
+package internal
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+
+	"google.golang.org/grpc/credentials"
+)
+
+func (o *options) transportCredentials() (credentials.TransportCredentials, error) {
+	if o.tlsConfig == nil && o.certFile == "" {
+		return nil, nil
+	}
+
+	config := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	if o.tlsConfig != nil {
+		config = o.tlsConfig.Clone()
+	}
+
+	if o.certFile != "" {
+		cert, err := tls.LoadX509KeyPair(o.certFile, o.keyFile)
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to load key pair: %w", err)
+		}
+
+		config.Certificates = append(config.Certificates, cert)
+	}
+
+	if o.clientCAFile != "" {
+		pem, err := os.ReadFile(o.clientCAFile)
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to read client CA: %w", err)
+		}
+
+		pool := x509.NewCertPool()
+
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in %s", o.clientCAFile)
+		}
+
+		config.ClientCAs = pool
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	return credentials.NewTLS(config), nil
+}
@@ -0,0 +1,21 @@
+package internal
+
+import (
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/stats"
+)
+
+// tracingStatsHandler starts a span per RPC, continuing any W3C trace context
+// found in the incoming metadata. The span is stored in the handler context,
+// so handlers can decorate it through trace.SpanFromContext.
+func tracingStatsHandler(tp trace.TracerProvider) stats.Handler {
+	return otelgrpc.NewServerHandler(
+		otelgrpc.WithTracerProvider(tp),
+		otelgrpc.WithPropagators(propagation.NewCompositeTextMapPropagator(
+			propagation.TraceContext{},
+			propagation.Baggage{},
+		)),
+	)
+}
@@ -0,0 +1,62 @@
+package client
+
+import (
+	"context"
+
+	"github.com/uandersonricardo/masterclass-go/pkg/pb"
+	"google.golang.org/grpc"
+)
+
+type FrameClient struct {
+	conn   *grpc.ClientConn
+	client pb.FrameServiceClient
+	opts   *options
+}
+
+func NewFrameClient(target string, opts ...ClientOption) (*FrameClient, error) {
+	o := defaultOptions()
+
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	conn, err := grpc.NewClient(target, grpc.WithTransportCredentials(o.creds))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &FrameClient{
+		conn:   conn,
+		client: pb.NewFrameServiceClient(conn),
+		opts:   o,
+	}, nil
+}
+
+func (c *FrameClient) GetFrame(ctx context.Context, id int32) (*pb.Frame, error) {
+	var frame *pb.Frame
+
+	err := c.invoke(ctx, func(ctx context.Context) error {
+		var err error
+
+		frame, err = c.client.GetFrame(ctx, &pb.GetFrameRequest{
+			Id: id,
+		}, c.opts.callOpts...)
+
+		return err
+	})
+
+	return frame, err
+}
+
+func (c *FrameClient) Close() error {
+	return c.conn.Close()
+}
+
+func (c *FrameClient) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if _, ok := ctx.Deadline(); ok || c.opts.timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+
+	return context.WithTimeout(ctx, c.opts.timeout)
+}
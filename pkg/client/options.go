@@ -0,0 +1,62 @@
+package client
+
+import (
+	"crypto/tls"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	_ "google.golang.org/grpc/encoding/gzip"
+)
+
+const defaultTimeout = 10 * time.Second
+
+type ClientOption func(*options)
+
+type options struct {
+	timeout  time.Duration
+	creds    credentials.TransportCredentials
+	callOpts []grpc.CallOption
+
+	maxAttempts int
+	baseBackoff time.Duration
+}
+
+// By default calls time out after 10 seconds, are not retried and connections
+// use TLS verified against the system roots.
+func defaultOptions() *options {
+	return &options{
+		timeout:     defaultTimeout,
+		creds:       credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12}),
+		maxAttempts: 1,
+	}
+}
+
+// WithTimeout sets the deadline applied to calls whose context has none.
+func WithTimeout(timeout time.Duration) ClientOption {
+	return func(o *options) {
+		o.timeout = timeout
+	}
+}
+
+func WithInsecure() ClientOption {
+	return func(o *options) {
+		o.creds = insecure.NewCredentials()
+	}
+}
+
+func WithTLS(config *tls.Config) ClientOption {
+	return func(o *options) {
+		o.creds = credentials.NewTLS(config)
+	}
+}
+
+// WithCompression compresses requests with the named compressor, such as
+// "gzip". Servers that do not support it reject the call with
+// codes.Unimplemented.
+func WithCompression(name string) ClientOption {
+	return func(o *options) {
+		o.callOpts = append(o.callOpts, grpc.UseCompressor(name))
+	}
+}
@@ -0,0 +1,95 @@
+package client
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxBackoff caps the wait between two attempts.
+const maxBackoff = 30 * time.Second
+
+// WithRetry retries calls failing with codes.Unavailable, or with
+// codes.DeadlineExceeded while the caller's own deadline has not passed, up
+// to maxAttempts attempts in total. After attempt n fails, the next one waits
+// a random duration of up to baseBackoff * 2^(n-1), capped at 30 seconds.
+func WithRetry(maxAttempts int, baseBackoff time.Duration) ClientOption {
+	return func(o *options) {
+		o.maxAttempts = maxAttempts
+		o.baseBackoff = baseBackoff
+	}
+}
+
+func retryable(ctx context.Context, err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable:
+		return true
+	case codes.DeadlineExceeded:
+		return ctx.Err() == nil
+	default:
+		return false
+	}
+}
+
+// invoke runs call until it succeeds, fails with a non retryable error, runs
+// out of attempts or ctx is done. Every attempt gets its own default timeout
+// when ctx has no deadline.
+func (c *FrameClient) invoke(ctx context.Context, call func(ctx context.Context) error) error {
+	var err error
+
+	for attempt := 1; ; attempt++ {
+		err = c.attempt(ctx, call)
+
+		if err == nil || attempt >= c.opts.maxAttempts || !retryable(ctx, err) {
+			return err
+		}
+
+		if !sleep(ctx, backoff(c.opts.baseBackoff, attempt)) {
+			return err
+		}
+	}
+}
+
+func (c *FrameClient) attempt(ctx context.Context, call func(ctx context.Context) error) error {
+	ctx, cancel := c.withTimeout(ctx)
+	defer cancel()
+
+	return call(ctx)
+}
+
+// backoff returns the wait after the given failed attempt. The limit is
+// clamped before shifting so large attempts cannot overflow.
+func backoff(base time.Duration, attempt int) time.Duration {
+	if base <= 0 {
+		return 0
+	}
+
+	limit := maxBackoff
+
+	if shift := attempt - 1; base <= maxBackoff>>shift {
+		limit = base << shift
+	}
+
+	return time.Duration(rand.Int63n(int64(limit)))
+}
+
+// sleep waits for d, returning false without waiting when ctx would expire
+// first.
+func sleep(ctx context.Context, d time.Duration) bool {
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < d {
+		return false
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
@@ -0,0 +1,138 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRetryable(t *testing.T) {
+	expired, cancel := context.WithTimeout(context.Background(), -time.Second)
+	defer cancel()
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		code codes.Code
+		want bool
+	}{
+		{name: "unavailable", ctx: context.Background(), code: codes.Unavailable, want: true},
+		{name: "attempt deadline", ctx: context.Background(), code: codes.DeadlineExceeded, want: true},
+		{name: "caller deadline", ctx: expired, code: codes.DeadlineExceeded, want: false},
+		{name: "not found", ctx: context.Background(), code: codes.NotFound, want: false},
+		{name: "invalid argument", ctx: context.Background(), code: codes.InvalidArgument, want: false},
+		{name: "internal", ctx: context.Background(), code: codes.Internal, want: false},
+		{name: "canceled", ctx: context.Background(), code: codes.Canceled, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := retryable(tt.ctx, status.Error(tt.code, "failed")); got != tt.want {
+				t.Errorf("retryable(%v) = %v, want %v", tt.code, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInvokeAttempts(t *testing.T) {
+	tests := []struct {
+		name string
+		code codes.Code
+		want int
+	}{
+		{name: "retries unavailable", code: codes.Unavailable, want: 3},
+		{name: "fails fast", code: codes.NotFound, want: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &FrameClient{opts: &options{maxAttempts: 3, baseBackoff: time.Millisecond}}
+			attempts := 0
+
+			err := c.invoke(context.Background(), func(ctx context.Context) error {
+				attempts++
+				return status.Error(tt.code, "failed")
+			})
+
+			if status.Code(err) != tt.code {
+				t.Errorf("invoke() = %v, want %v", err, tt.code)
+			}
+
+			if attempts != tt.want {
+				t.Errorf("invoke() made %d attempts, want %d", attempts, tt.want)
+			}
+		})
+	}
+}
+
+func TestInvokeStopsAtDeadline(t *testing.T) {
+	c := &FrameClient{opts: &options{maxAttempts: 10, baseBackoff: time.Hour}}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	attempts := 0
+	start := time.Now()
+
+	err := c.invoke(ctx, func(ctx context.Context) error {
+		attempts++
+		return status.Error(codes.Unavailable, "failed")
+	})
+
+	if status.Code(err) != codes.Unavailable || attempts != 1 {
+		t.Errorf("invoke() = %v after %d attempts, want Unavailable after 1", err, attempts)
+	}
+
+	// A backoff past the deadline is not waited for.
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("invoke() returned after %v, want no wait", elapsed)
+	}
+}
+
+func TestSleep(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if sleep(ctx, time.Hour) {
+		t.Error("sleep() past the deadline = true, want false")
+	}
+
+	if !sleep(ctx, time.Millisecond) {
+		t.Error("sleep() before the deadline = false, want true")
+	}
+
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if sleep(canceled, time.Hour) {
+		t.Error("sleep() with a canceled context = true, want false")
+	}
+}
+
+func TestBackoffBounds(t *testing.T) {
+	tests := []struct {
+		base    time.Duration
+		attempt int
+		limit   time.Duration
+	}{
+		{base: 100 * time.Millisecond, attempt: 1, limit: 100 * time.Millisecond},
+		{base: 100 * time.Millisecond, attempt: 4, limit: 800 * time.Millisecond},
+		{base: 100 * time.Millisecond, attempt: 20, limit: maxBackoff},
+		{base: 100 * time.Millisecond, attempt: 64, limit: maxBackoff},
+		{base: 100 * time.Millisecond, attempt: 1000, limit: maxBackoff},
+		{base: time.Hour, attempt: 1, limit: maxBackoff},
+		{base: 0, attempt: 5, limit: 0},
+	}
+
+	for _, tt := range tests {
+		for i := 0; i < 100; i++ {
+			d := backoff(tt.base, tt.attempt)
+
+			if d < 0 || d > tt.limit || (tt.limit > 0 && d == tt.limit) {
+				t.Fatalf("backoff(%v, %d) = %v, want in [0, %v)", tt.base, tt.attempt, d, tt.limit)
+			}
+		}
+	}
+}
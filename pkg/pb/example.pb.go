@@ -7,6 +7,7 @@
 package pb
 
 import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -20,31 +21,794 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type WatchRequest_Command int32
+
+const (
+	WatchRequest_COMMAND_UNSPECIFIED WatchRequest_Command = 0
+	WatchRequest_PAUSE               WatchRequest_Command = 1
+	WatchRequest_RESUME              WatchRequest_Command = 2
+	WatchRequest_SEEK                WatchRequest_Command = 3
+)
+
+// Enum value maps for WatchRequest_Command.
+var (
+	WatchRequest_Command_name = map[int32]string{
+		0: "COMMAND_UNSPECIFIED",
+		1: "PAUSE",
+		2: "RESUME",
+		3: "SEEK",
+	}
+	WatchRequest_Command_value = map[string]int32{
+		"COMMAND_UNSPECIFIED": 0,
+		"PAUSE":               1,
+		"RESUME":              2,
+		"SEEK":                3,
+	}
+)
+
+func (x WatchRequest_Command) Enum() *WatchRequest_Command {
+	p := new(WatchRequest_Command)
+	*p = x
+	return p
+}
+
+func (x WatchRequest_Command) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WatchRequest_Command) Descriptor() protoreflect.EnumDescriptor {
+	return file_protos_example_proto_enumTypes[0].Descriptor()
+}
+
+func (WatchRequest_Command) Type() protoreflect.EnumType {
+	return &file_protos_example_proto_enumTypes[0]
+}
+
+func (x WatchRequest_Command) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WatchRequest_Command.Descriptor instead.
+func (WatchRequest_Command) EnumDescriptor() ([]byte, []int) {
+	return file_protos_example_proto_rawDescGZIP(), []int{6, 0}
+}
+
 type GetFrameRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// When set, the frame is downscaled to fit within these bounds and
+	// returned as a JPEG.
+	MaxWidth  uint32 `protobuf:"varint,2,opt,name=max_width,json=maxWidth,proto3" json:"max_width,omitempty"`
+	MaxHeight uint32 `protobuf:"varint,3,opt,name=max_height,json=maxHeight,proto3" json:"max_height,omitempty"`
+}
+
+func (x *GetFrameRequest) Reset() {
+	*x = GetFrameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_example_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFrameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFrameRequest) ProtoMessage() {}
+
+func (x *GetFrameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_example_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFrameRequest.ProtoReflect.Descriptor instead.
+func (*GetFrameRequest) Descriptor() ([]byte, []int) {
+	return file_protos_example_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetFrameRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetFrameRequest) GetMaxWidth() uint32 {
+	if x != nil {
+		return x.MaxWidth
+	}
+	return 0
+}
+
+func (x *GetFrameRequest) GetMaxHeight() uint32 {
+	if x != nil {
+		return x.MaxHeight
+	}
+	return 0
+}
+
+type StreamFramesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartId int32  `protobuf:"varint,1,opt,name=start_id,json=startId,proto3" json:"start_id,omitempty"`
+	EndId   int32  `protobuf:"varint,2,opt,name=end_id,json=endId,proto3" json:"end_id,omitempty"`
+	Step    *int32 `protobuf:"varint,3,opt,name=step,proto3,oneof" json:"step,omitempty"`
+}
+
+func (x *StreamFramesRequest) Reset() {
+	*x = StreamFramesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_example_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamFramesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamFramesRequest) ProtoMessage() {}
+
+func (x *StreamFramesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_example_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamFramesRequest.ProtoReflect.Descriptor instead.
+func (*StreamFramesRequest) Descriptor() ([]byte, []int) {
+	return file_protos_example_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StreamFramesRequest) GetStartId() int32 {
+	if x != nil {
+		return x.StartId
+	}
+	return 0
+}
+
+func (x *StreamFramesRequest) GetEndId() int32 {
+	if x != nil {
+		return x.EndId
+	}
+	return 0
+}
+
+func (x *StreamFramesRequest) GetStep() int32 {
+	if x != nil && x.Step != nil {
+		return *x.Step
+	}
+	return 0
+}
+
+type ListFramesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageSize  int32  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *ListFramesRequest) Reset() {
+	*x = ListFramesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_example_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFramesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFramesRequest) ProtoMessage() {}
+
+func (x *ListFramesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_example_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFramesRequest.ProtoReflect.Descriptor instead.
+func (*ListFramesRequest) Descriptor() ([]byte, []int) {
+	return file_protos_example_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListFramesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListFramesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListFramesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Frames        []*Frame `protobuf:"bytes,1,rep,name=frames,proto3" json:"frames,omitempty"`
+	NextPageToken string   `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListFramesResponse) Reset() {
+	*x = ListFramesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_example_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFramesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFramesResponse) ProtoMessage() {}
+
+func (x *ListFramesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_example_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFramesResponse.ProtoReflect.Descriptor instead.
+func (*ListFramesResponse) Descriptor() ([]byte, []int) {
+	return file_protos_example_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListFramesResponse) GetFrames() []*Frame {
+	if x != nil {
+		return x.Frames
+	}
+	return nil
+}
+
+func (x *ListFramesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type DeleteFrameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteFrameRequest) Reset() {
+	*x = DeleteFrameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_example_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteFrameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFrameRequest) ProtoMessage() {}
+
+func (x *DeleteFrameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_example_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFrameRequest.ProtoReflect.Descriptor instead.
+func (*DeleteFrameRequest) Descriptor() ([]byte, []int) {
+	return file_protos_example_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DeleteFrameRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteFrameResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Deleted bool `protobuf:"varint,1,opt,name=deleted,proto3" json:"deleted,omitempty"`
+}
+
+func (x *DeleteFrameResponse) Reset() {
+	*x = DeleteFrameResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_example_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteFrameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFrameResponse) ProtoMessage() {}
+
+func (x *DeleteFrameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_example_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFrameResponse.ProtoReflect.Descriptor instead.
+func (*DeleteFrameResponse) Descriptor() ([]byte, []int) {
+	return file_protos_example_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteFrameResponse) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+type WatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Command WatchRequest_Command `protobuf:"varint,1,opt,name=command,proto3,enum=masterclass.go.WatchRequest_Command" json:"command,omitempty"`
+	SeekId  int32                `protobuf:"varint,2,opt,name=seek_id,json=seekId,proto3" json:"seek_id,omitempty"`
+}
+
+func (x *WatchRequest) Reset() {
+	*x = WatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_example_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchRequest) ProtoMessage() {}
+
+func (x *WatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_example_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchRequest.ProtoReflect.Descriptor instead.
+func (*WatchRequest) Descriptor() ([]byte, []int) {
+	return file_protos_example_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *WatchRequest) GetCommand() WatchRequest_Command {
+	if x != nil {
+		return x.Command
+	}
+	return WatchRequest_COMMAND_UNSPECIFIED
+}
+
+func (x *WatchRequest) GetSeekId() int32 {
+	if x != nil {
+		return x.SeekId
+	}
+	return 0
+}
+
+type BatchGetFramesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids []int32 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (x *BatchGetFramesRequest) Reset() {
+	*x = BatchGetFramesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_example_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchGetFramesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetFramesRequest) ProtoMessage() {}
+
+func (x *BatchGetFramesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_example_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetFramesRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetFramesRequest) Descriptor() ([]byte, []int) {
+	return file_protos_example_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *BatchGetFramesRequest) GetIds() []int32 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type BatchGetFramesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Frames      []*Frame `protobuf:"bytes,1,rep,name=frames,proto3" json:"frames,omitempty"`
+	NotFoundIds []int32  `protobuf:"varint,2,rep,packed,name=not_found_ids,json=notFoundIds,proto3" json:"not_found_ids,omitempty"`
+}
+
+func (x *BatchGetFramesResponse) Reset() {
+	*x = BatchGetFramesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_example_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchGetFramesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetFramesResponse) ProtoMessage() {}
+
+func (x *BatchGetFramesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_example_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetFramesResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetFramesResponse) Descriptor() ([]byte, []int) {
+	return file_protos_example_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *BatchGetFramesResponse) GetFrames() []*Frame {
+	if x != nil {
+		return x.Frames
+	}
+	return nil
+}
+
+func (x *BatchGetFramesResponse) GetNotFoundIds() []int32 {
+	if x != nil {
+		return x.NotFoundIds
+	}
+	return nil
+}
+
+type ConvertFrameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Encoding string `protobuf:"bytes,2,opt,name=encoding,proto3" json:"encoding,omitempty"`
+}
+
+func (x *ConvertFrameRequest) Reset() {
+	*x = ConvertFrameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_example_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConvertFrameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConvertFrameRequest) ProtoMessage() {}
+
+func (x *ConvertFrameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_example_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConvertFrameRequest.ProtoReflect.Descriptor instead.
+func (*ConvertFrameRequest) Descriptor() ([]byte, []int) {
+	return file_protos_example_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ConvertFrameRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ConvertFrameRequest) GetEncoding() string {
+	if x != nil {
+		return x.Encoding
+	}
+	return ""
+}
+
+type PutFrameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Frame *Frame `protobuf:"bytes,1,opt,name=frame,proto3" json:"frame,omitempty"`
+	// Fail with ALREADY_EXISTS instead of overwriting an existing frame.
+	IfNotExists bool `protobuf:"varint,2,opt,name=if_not_exists,json=ifNotExists,proto3" json:"if_not_exists,omitempty"`
+}
+
+func (x *PutFrameRequest) Reset() {
+	*x = PutFrameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_example_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PutFrameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutFrameRequest) ProtoMessage() {}
+
+func (x *PutFrameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_example_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutFrameRequest.ProtoReflect.Descriptor instead.
+func (*PutFrameRequest) Descriptor() ([]byte, []int) {
+	return file_protos_example_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PutFrameRequest) GetFrame() *Frame {
+	if x != nil {
+		return x.Frame
+	}
+	return nil
+}
+
+func (x *PutFrameRequest) GetIfNotExists() bool {
+	if x != nil {
+		return x.IfNotExists
+	}
+	return false
+}
+
+type PutFrameResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Created bool  `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+}
+
+func (x *PutFrameResponse) Reset() {
+	*x = PutFrameResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_example_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PutFrameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutFrameResponse) ProtoMessage() {}
+
+func (x *PutFrameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_example_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutFrameResponse.ProtoReflect.Descriptor instead.
+func (*PutFrameResponse) Descriptor() ([]byte, []int) {
+	return file_protos_example_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *PutFrameResponse) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PutFrameResponse) GetCreated() bool {
+	if x != nil {
+		return x.Created
+	}
+	return false
+}
+
+type GetFrameRangeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartId int32 `protobuf:"varint,1,opt,name=start_id,json=startId,proto3" json:"start_id,omitempty"`
+	Count   int32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *GetFrameRangeRequest) Reset() {
+	*x = GetFrameRangeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_example_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFrameRangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFrameRangeRequest) ProtoMessage() {}
+
+func (x *GetFrameRangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_example_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFrameRangeRequest.ProtoReflect.Descriptor instead.
+func (*GetFrameRangeRequest) Descriptor() ([]byte, []int) {
+	return file_protos_example_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetFrameRangeRequest) GetStartId() int32 {
+	if x != nil {
+		return x.StartId
+	}
+	return 0
+}
+
+func (x *GetFrameRangeRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type FrameSequence struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Frames     []*Frame `protobuf:"bytes,1,rep,name=frames,proto3" json:"frames,omitempty"`
+	MissingIds []int32  `protobuf:"varint,2,rep,packed,name=missing_ids,json=missingIds,proto3" json:"missing_ids,omitempty"`
 }
 
-func (x *GetFrameRequest) Reset() {
-	*x = GetFrameRequest{}
+func (x *FrameSequence) Reset() {
+	*x = FrameSequence{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_example_proto_msgTypes[0]
+		mi := &file_protos_example_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetFrameRequest) String() string {
+func (x *FrameSequence) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFrameRequest) ProtoMessage() {}
+func (*FrameSequence) ProtoMessage() {}
 
-func (x *GetFrameRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_example_proto_msgTypes[0]
+func (x *FrameSequence) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_example_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -55,16 +819,23 @@ func (x *GetFrameRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFrameRequest.ProtoReflect.Descriptor instead.
-func (*GetFrameRequest) Descriptor() ([]byte, []int) {
-	return file_protos_example_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use FrameSequence.ProtoReflect.Descriptor instead.
+func (*FrameSequence) Descriptor() ([]byte, []int) {
+	return file_protos_example_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *GetFrameRequest) GetId() int32 {
+func (x *FrameSequence) GetFrames() []*Frame {
 	if x != nil {
-		return x.Id
+		return x.Frames
 	}
-	return 0
+	return nil
+}
+
+func (x *FrameSequence) GetMissingIds() []int32 {
+	if x != nil {
+		return x.MissingIds
+	}
+	return nil
 }
 
 type Frame struct {
@@ -72,13 +843,20 @@ type Frame struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id          int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Data        []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	TimestampMs int64  `protobuf:"varint,3,opt,name=timestamp_ms,json=timestampMs,proto3" json:"timestamp_ms,omitempty"`
+	Width       uint32 `protobuf:"varint,4,opt,name=width,proto3" json:"width,omitempty"`
+	Height      uint32 `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	Encoding    string `protobuf:"bytes,6,opt,name=encoding,proto3" json:"encoding,omitempty"`
+	// Hex encoded SHA-256 of data.
+	Checksum string `protobuf:"bytes,7,opt,name=checksum,proto3" json:"checksum,omitempty"`
 }
 
 func (x *Frame) Reset() {
 	*x = Frame{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_example_proto_msgTypes[1]
+		mi := &file_protos_example_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -91,7 +869,7 @@ func (x *Frame) String() string {
 func (*Frame) ProtoMessage() {}
 
 func (x *Frame) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_example_proto_msgTypes[1]
+	mi := &file_protos_example_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -104,7 +882,7 @@ func (x *Frame) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Frame.ProtoReflect.Descriptor instead.
 func (*Frame) Descriptor() ([]byte, []int) {
-	return file_protos_example_proto_rawDescGZIP(), []int{1}
+	return file_protos_example_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *Frame) GetId() int32 {
@@ -114,25 +892,265 @@ func (x *Frame) GetId() int32 {
 	return 0
 }
 
+func (x *Frame) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *Frame) GetTimestampMs() int64 {
+	if x != nil {
+		return x.TimestampMs
+	}
+	return 0
+}
+
+func (x *Frame) GetWidth() uint32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *Frame) GetHeight() uint32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Frame) GetEncoding() string {
+	if x != nil {
+		return x.Encoding
+	}
+	return ""
+}
+
+func (x *Frame) GetChecksum() string {
+	if x != nil {
+		return x.Checksum
+	}
+	return ""
+}
+
+type UploadFramesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count     int32   `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	FailedIds []int32 `protobuf:"varint,2,rep,packed,name=failed_ids,json=failedIds,proto3" json:"failed_ids,omitempty"`
+}
+
+func (x *UploadFramesResponse) Reset() {
+	*x = UploadFramesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_example_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadFramesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadFramesResponse) ProtoMessage() {}
+
+func (x *UploadFramesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_example_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadFramesResponse.ProtoReflect.Descriptor instead.
+func (*UploadFramesResponse) Descriptor() ([]byte, []int) {
+	return file_protos_example_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UploadFramesResponse) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *UploadFramesResponse) GetFailedIds() []int32 {
+	if x != nil {
+		return x.FailedIds
+	}
+	return nil
+}
+
 var File_protos_example_proto protoreflect.FileDescriptor
 
 var file_protos_example_proto_rawDesc = []byte{
 	0x0a, 0x14, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x63, 0x6c,
-	0x61, 0x73, 0x73, 0x2e, 0x67, 0x6f, 0x22, 0x21, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x46, 0x72, 0x61,
-	0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0x17, 0x0a, 0x05, 0x46, 0x72, 0x61,
-	0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02,
-	0x69, 0x64, 0x32, 0x54, 0x0a, 0x0c, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x12, 0x44, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x12, 0x1f,
-	0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x2e, 0x67, 0x6f, 0x2e,
-	0x47, 0x65, 0x74, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x61, 0x73, 0x73, 0x2e, 0x67, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x5d, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x46, 0x72, 0x61, 0x6d, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x77,
+	0x69, 0x64, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x57,
+	0x69, 0x64, 0x74, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x22, 0x69, 0x0a, 0x13, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x72, 0x61,
+	0x6d, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x65, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x65, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x04,
+	0x73, 0x74, 0x65, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x04, 0x73, 0x74,
+	0x65, 0x70, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x22, 0x4f,
+	0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0x6b, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x63, 0x6c,
+	0x61, 0x73, 0x73, 0x2e, 0x67, 0x6f, 0x2e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x06, 0x66, 0x72,
+	0x61, 0x6d, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e,
+	0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x24, 0x0a, 0x12,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x2f, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x72, 0x61, 0x6d,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x22, 0xac, 0x01, 0x0a, 0x0c, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x63, 0x6c,
+	0x61, 0x73, 0x73, 0x2e, 0x67, 0x6f, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x07, 0x63, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x65, 0x65, 0x6b, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x65, 0x65, 0x6b, 0x49, 0x64, 0x22, 0x43, 0x0a,
+	0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x17, 0x0a, 0x13, 0x43, 0x4f, 0x4d, 0x4d,
+	0x41, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x09, 0x0a, 0x05, 0x50, 0x41, 0x55, 0x53, 0x45, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06,
+	0x52, 0x45, 0x53, 0x55, 0x4d, 0x45, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x45, 0x45, 0x4b,
+	0x10, 0x03, 0x22, 0x29, 0x0a, 0x15, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x46, 0x72,
+	0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69,
+	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x6b, 0x0a,
+	0x16, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x66, 0x72, 0x61, 0x6d, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72,
+	0x63, 0x6c, 0x61, 0x73, 0x73, 0x2e, 0x67, 0x6f, 0x2e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x06,
+	0x66, 0x72, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x6e, 0x6f, 0x74, 0x5f, 0x66, 0x6f,
+	0x75, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0b, 0x6e,
+	0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x73, 0x22, 0x41, 0x0a, 0x13, 0x43, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x74, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x62, 0x0a,
+	0x0f, 0x50, 0x75, 0x74, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x2b, 0x0a, 0x05, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x15, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x2e, 0x67, 0x6f,
-	0x2e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x22, 0x00, 0x42, 0x33, 0x5a, 0x31, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x75, 0x61, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x6f, 0x6e,
-	0x72, 0x69, 0x63, 0x61, 0x72, 0x64, 0x6f, 0x2f, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x63, 0x6c,
-	0x61, 0x73, 0x73, 0x2d, 0x67, 0x6f, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x2e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x05, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a,
+	0x0d, 0x69, 0x66, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x66, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74,
+	0x73, 0x22, 0x3c, 0x0a, 0x10, 0x50, 0x75, 0x74, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x22,
+	0x47, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x5f, 0x0a, 0x0d, 0x46, 0x72, 0x61, 0x6d,
+	0x65, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x66, 0x72, 0x61,
+	0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x61, 0x73, 0x74,
+	0x65, 0x72, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x2e, 0x67, 0x6f, 0x2e, 0x46, 0x72, 0x61, 0x6d, 0x65,
+	0x52, 0x06, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0a, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x73, 0x22, 0xb4, 0x01, 0x0a, 0x05, 0x46, 0x72,
+	0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x5f, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4d, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69,
+	0x64, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68,
+	0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x63, 0x6f,
+	0x64, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x63, 0x6f,
+	0x64, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d,
+	0x22, 0x4b, 0x0a, 0x14, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x05, 0x52, 0x09, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x49, 0x64, 0x73, 0x32, 0xa5, 0x07,
+	0x0a, 0x0c, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5b,
+	0x0a, 0x08, 0x47, 0x65, 0x74, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x2e, 0x6d, 0x61, 0x73,
+	0x74, 0x65, 0x72, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x2e, 0x67, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x46,
+	0x72, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x6d, 0x61,
+	0x73, 0x74, 0x65, 0x72, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x2e, 0x67, 0x6f, 0x2e, 0x46, 0x72, 0x61,
+	0x6d, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x76, 0x31, 0x2f,
+	0x66, 0x72, 0x61, 0x6d, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x4e, 0x0a, 0x0c, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x23, 0x2e, 0x6d, 0x61,
+	0x73, 0x74, 0x65, 0x72, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x2e, 0x67, 0x6f, 0x2e, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x15, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x2e, 0x67,
+	0x6f, 0x2e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x4f, 0x0a, 0x0c, 0x55,
+	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x15, 0x2e, 0x6d, 0x61,
+	0x73, 0x74, 0x65, 0x72, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x2e, 0x67, 0x6f, 0x2e, 0x46, 0x72, 0x61,
+	0x6d, 0x65, 0x1a, 0x24, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x63, 0x6c, 0x61, 0x73, 0x73,
+	0x2e, 0x67, 0x6f, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x12, 0x67, 0x0a, 0x0a,
+	0x4c, 0x69, 0x73, 0x74, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x21, 0x2e, 0x6d, 0x61, 0x73,
+	0x74, 0x65, 0x72, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x2e, 0x67, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x46, 0x72, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e,
+	0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x2e, 0x67, 0x6f, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x12, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0c, 0x12, 0x0a, 0x2f, 0x76, 0x31, 0x2f, 0x66,
+	0x72, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x6f, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46,
+	0x72, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x63, 0x6c, 0x61,
+	0x73, 0x73, 0x2e, 0x67, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x72, 0x61, 0x6d,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65,
+	0x72, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x2e, 0x67, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x11, 0x2a, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x72, 0x61, 0x6d, 0x65,
+	0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x48, 0x0a, 0x0b, 0x57, 0x61, 0x74, 0x63, 0x68, 0x46,
+	0x72, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x63, 0x6c,
+	0x61, 0x73, 0x73, 0x2e, 0x67, 0x6f, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x63, 0x6c, 0x61, 0x73,
+	0x73, 0x2e, 0x67, 0x6f, 0x2e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01,
+	0x12, 0x7c, 0x0a, 0x0e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x46, 0x72, 0x61, 0x6d,
+	0x65, 0x73, 0x12, 0x25, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x63, 0x6c, 0x61, 0x73, 0x73,
+	0x2e, 0x67, 0x6f, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x46, 0x72, 0x61, 0x6d,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6d, 0x61, 0x73, 0x74,
+	0x65, 0x72, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x2e, 0x67, 0x6f, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x47, 0x65, 0x74, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x66,
+	0x72, 0x61, 0x6d, 0x65, 0x73, 0x3a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x12, 0x4c,
+	0x0a, 0x0c, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x12, 0x23,
+	0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x2e, 0x67, 0x6f, 0x2e,
+	0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x63, 0x6c, 0x61, 0x73,
+	0x73, 0x2e, 0x67, 0x6f, 0x2e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x08,
+	0x50, 0x75, 0x74, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65,
+	0x72, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x2e, 0x67, 0x6f, 0x2e, 0x50, 0x75, 0x74, 0x46, 0x72, 0x61,
+	0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6d, 0x61, 0x73, 0x74,
+	0x65, 0x72, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x2e, 0x67, 0x6f, 0x2e, 0x50, 0x75, 0x74, 0x46, 0x72,
+	0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x56, 0x0a,
+	0x0d, 0x47, 0x65, 0x74, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x24,
+	0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x2e, 0x67, 0x6f, 0x2e,
+	0x47, 0x65, 0x74, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x63, 0x6c, 0x61,
+	0x73, 0x73, 0x2e, 0x67, 0x6f, 0x2e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x53, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x65, 0x22, 0x00, 0x42, 0x33, 0x5a, 0x31, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x75, 0x61, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x72, 0x69, 0x63,
+	0x61, 0x72, 0x64, 0x6f, 0x2f, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x63, 0x6c, 0x61, 0x73, 0x73,
+	0x2d, 0x67, 0x6f, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -147,19 +1165,58 @@ func file_protos_example_proto_rawDescGZIP() []byte {
 	return file_protos_example_proto_rawDescData
 }
 
-var file_protos_example_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_protos_example_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_protos_example_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_protos_example_proto_goTypes = []interface{}{
-	(*GetFrameRequest)(nil), // 0: masterclass.go.GetFrameRequest
-	(*Frame)(nil),           // 1: masterclass.go.Frame
+	(WatchRequest_Command)(0),      // 0: masterclass.go.WatchRequest.Command
+	(*GetFrameRequest)(nil),        // 1: masterclass.go.GetFrameRequest
+	(*StreamFramesRequest)(nil),    // 2: masterclass.go.StreamFramesRequest
+	(*ListFramesRequest)(nil),      // 3: masterclass.go.ListFramesRequest
+	(*ListFramesResponse)(nil),     // 4: masterclass.go.ListFramesResponse
+	(*DeleteFrameRequest)(nil),     // 5: masterclass.go.DeleteFrameRequest
+	(*DeleteFrameResponse)(nil),    // 6: masterclass.go.DeleteFrameResponse
+	(*WatchRequest)(nil),           // 7: masterclass.go.WatchRequest
+	(*BatchGetFramesRequest)(nil),  // 8: masterclass.go.BatchGetFramesRequest
+	(*BatchGetFramesResponse)(nil), // 9: masterclass.go.BatchGetFramesResponse
+	(*ConvertFrameRequest)(nil),    // 10: masterclass.go.ConvertFrameRequest
+	(*PutFrameRequest)(nil),        // 11: masterclass.go.PutFrameRequest
+	(*PutFrameResponse)(nil),       // 12: masterclass.go.PutFrameResponse
+	(*GetFrameRangeRequest)(nil),   // 13: masterclass.go.GetFrameRangeRequest
+	(*FrameSequence)(nil),          // 14: masterclass.go.FrameSequence
+	(*Frame)(nil),                  // 15: masterclass.go.Frame
+	(*UploadFramesResponse)(nil),   // 16: masterclass.go.UploadFramesResponse
 }
 var file_protos_example_proto_depIdxs = []int32{
-	0, // 0: masterclass.go.FrameService.GetFrame:input_type -> masterclass.go.GetFrameRequest
-	1, // 1: masterclass.go.FrameService.GetFrame:output_type -> masterclass.go.Frame
-	1, // [1:2] is the sub-list for method output_type
-	0, // [0:1] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	15, // 0: masterclass.go.ListFramesResponse.frames:type_name -> masterclass.go.Frame
+	0,  // 1: masterclass.go.WatchRequest.command:type_name -> masterclass.go.WatchRequest.Command
+	15, // 2: masterclass.go.BatchGetFramesResponse.frames:type_name -> masterclass.go.Frame
+	15, // 3: masterclass.go.PutFrameRequest.frame:type_name -> masterclass.go.Frame
+	15, // 4: masterclass.go.FrameSequence.frames:type_name -> masterclass.go.Frame
+	1,  // 5: masterclass.go.FrameService.GetFrame:input_type -> masterclass.go.GetFrameRequest
+	2,  // 6: masterclass.go.FrameService.StreamFrames:input_type -> masterclass.go.StreamFramesRequest
+	15, // 7: masterclass.go.FrameService.UploadFrames:input_type -> masterclass.go.Frame
+	3,  // 8: masterclass.go.FrameService.ListFrames:input_type -> masterclass.go.ListFramesRequest
+	5,  // 9: masterclass.go.FrameService.DeleteFrame:input_type -> masterclass.go.DeleteFrameRequest
+	7,  // 10: masterclass.go.FrameService.WatchFrames:input_type -> masterclass.go.WatchRequest
+	8,  // 11: masterclass.go.FrameService.BatchGetFrames:input_type -> masterclass.go.BatchGetFramesRequest
+	10, // 12: masterclass.go.FrameService.ConvertFrame:input_type -> masterclass.go.ConvertFrameRequest
+	11, // 13: masterclass.go.FrameService.PutFrame:input_type -> masterclass.go.PutFrameRequest
+	13, // 14: masterclass.go.FrameService.GetFrameRange:input_type -> masterclass.go.GetFrameRangeRequest
+	15, // 15: masterclass.go.FrameService.GetFrame:output_type -> masterclass.go.Frame
+	15, // 16: masterclass.go.FrameService.StreamFrames:output_type -> masterclass.go.Frame
+	16, // 17: masterclass.go.FrameService.UploadFrames:output_type -> masterclass.go.UploadFramesResponse
+	4,  // 18: masterclass.go.FrameService.ListFrames:output_type -> masterclass.go.ListFramesResponse
+	6,  // 19: masterclass.go.FrameService.DeleteFrame:output_type -> masterclass.go.DeleteFrameResponse
+	15, // 20: masterclass.go.FrameService.WatchFrames:output_type -> masterclass.go.Frame
+	9,  // 21: masterclass.go.FrameService.BatchGetFrames:output_type -> masterclass.go.BatchGetFramesResponse
+	15, // 22: masterclass.go.FrameService.ConvertFrame:output_type -> masterclass.go.Frame
+	12, // 23: masterclass.go.FrameService.PutFrame:output_type -> masterclass.go.PutFrameResponse
+	14, // 24: masterclass.go.FrameService.GetFrameRange:output_type -> masterclass.go.FrameSequence
+	15, // [15:25] is the sub-list for method output_type
+	5,  // [5:15] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_protos_example_proto_init() }
@@ -181,6 +1238,162 @@ func file_protos_example_proto_init() {
 			}
 		}
 		file_protos_example_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamFramesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_example_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFramesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_example_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFramesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_example_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteFrameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_example_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteFrameResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_example_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_example_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchGetFramesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_example_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchGetFramesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_example_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConvertFrameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_example_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PutFrameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_example_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PutFrameResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_example_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFrameRangeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_example_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FrameSequence); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_example_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Frame); i {
 			case 0:
 				return &v.state
@@ -192,19 +1405,33 @@ func file_protos_example_proto_init() {
 				return nil
 			}
 		}
+		file_protos_example_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadFramesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
+	file_protos_example_proto_msgTypes[1].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_protos_example_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   2,
+			NumEnums:      1,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_protos_example_proto_goTypes,
 		DependencyIndexes: file_protos_example_proto_depIdxs,
+		EnumInfos:         file_protos_example_proto_enumTypes,
 		MessageInfos:      file_protos_example_proto_msgTypes,
 	}.Build()
 	File_protos_example_proto = out.File
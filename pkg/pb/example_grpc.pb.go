@@ -23,6 +23,15 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type FrameServiceClient interface {
 	GetFrame(ctx context.Context, in *GetFrameRequest, opts ...grpc.CallOption) (*Frame, error)
+	StreamFrames(ctx context.Context, in *StreamFramesRequest, opts ...grpc.CallOption) (FrameService_StreamFramesClient, error)
+	UploadFrames(ctx context.Context, opts ...grpc.CallOption) (FrameService_UploadFramesClient, error)
+	ListFrames(ctx context.Context, in *ListFramesRequest, opts ...grpc.CallOption) (*ListFramesResponse, error)
+	DeleteFrame(ctx context.Context, in *DeleteFrameRequest, opts ...grpc.CallOption) (*DeleteFrameResponse, error)
+	WatchFrames(ctx context.Context, opts ...grpc.CallOption) (FrameService_WatchFramesClient, error)
+	BatchGetFrames(ctx context.Context, in *BatchGetFramesRequest, opts ...grpc.CallOption) (*BatchGetFramesResponse, error)
+	ConvertFrame(ctx context.Context, in *ConvertFrameRequest, opts ...grpc.CallOption) (*Frame, error)
+	PutFrame(ctx context.Context, in *PutFrameRequest, opts ...grpc.CallOption) (*PutFrameResponse, error)
+	GetFrameRange(ctx context.Context, in *GetFrameRangeRequest, opts ...grpc.CallOption) (*FrameSequence, error)
 }
 
 type frameServiceClient struct {
@@ -42,11 +51,171 @@ func (c *frameServiceClient) GetFrame(ctx context.Context, in *GetFrameRequest,
 	return out, nil
 }
 
+func (c *frameServiceClient) StreamFrames(ctx context.Context, in *StreamFramesRequest, opts ...grpc.CallOption) (FrameService_StreamFramesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &FrameService_ServiceDesc.Streams[0], "/masterclass.go.FrameService/StreamFrames", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &frameServiceStreamFramesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type FrameService_StreamFramesClient interface {
+	Recv() (*Frame, error)
+	grpc.ClientStream
+}
+
+type frameServiceStreamFramesClient struct {
+	grpc.ClientStream
+}
+
+func (x *frameServiceStreamFramesClient) Recv() (*Frame, error) {
+	m := new(Frame)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *frameServiceClient) UploadFrames(ctx context.Context, opts ...grpc.CallOption) (FrameService_UploadFramesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &FrameService_ServiceDesc.Streams[1], "/masterclass.go.FrameService/UploadFrames", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &frameServiceUploadFramesClient{stream}
+	return x, nil
+}
+
+type FrameService_UploadFramesClient interface {
+	Send(*Frame) error
+	CloseAndRecv() (*UploadFramesResponse, error)
+	grpc.ClientStream
+}
+
+type frameServiceUploadFramesClient struct {
+	grpc.ClientStream
+}
+
+func (x *frameServiceUploadFramesClient) Send(m *Frame) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *frameServiceUploadFramesClient) CloseAndRecv() (*UploadFramesResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(UploadFramesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *frameServiceClient) ListFrames(ctx context.Context, in *ListFramesRequest, opts ...grpc.CallOption) (*ListFramesResponse, error) {
+	out := new(ListFramesResponse)
+	err := c.cc.Invoke(ctx, "/masterclass.go.FrameService/ListFrames", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *frameServiceClient) DeleteFrame(ctx context.Context, in *DeleteFrameRequest, opts ...grpc.CallOption) (*DeleteFrameResponse, error) {
+	out := new(DeleteFrameResponse)
+	err := c.cc.Invoke(ctx, "/masterclass.go.FrameService/DeleteFrame", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *frameServiceClient) WatchFrames(ctx context.Context, opts ...grpc.CallOption) (FrameService_WatchFramesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &FrameService_ServiceDesc.Streams[2], "/masterclass.go.FrameService/WatchFrames", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &frameServiceWatchFramesClient{stream}
+	return x, nil
+}
+
+type FrameService_WatchFramesClient interface {
+	Send(*WatchRequest) error
+	Recv() (*Frame, error)
+	grpc.ClientStream
+}
+
+type frameServiceWatchFramesClient struct {
+	grpc.ClientStream
+}
+
+func (x *frameServiceWatchFramesClient) Send(m *WatchRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *frameServiceWatchFramesClient) Recv() (*Frame, error) {
+	m := new(Frame)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *frameServiceClient) BatchGetFrames(ctx context.Context, in *BatchGetFramesRequest, opts ...grpc.CallOption) (*BatchGetFramesResponse, error) {
+	out := new(BatchGetFramesResponse)
+	err := c.cc.Invoke(ctx, "/masterclass.go.FrameService/BatchGetFrames", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *frameServiceClient) ConvertFrame(ctx context.Context, in *ConvertFrameRequest, opts ...grpc.CallOption) (*Frame, error) {
+	out := new(Frame)
+	err := c.cc.Invoke(ctx, "/masterclass.go.FrameService/ConvertFrame", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *frameServiceClient) PutFrame(ctx context.Context, in *PutFrameRequest, opts ...grpc.CallOption) (*PutFrameResponse, error) {
+	out := new(PutFrameResponse)
+	err := c.cc.Invoke(ctx, "/masterclass.go.FrameService/PutFrame", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *frameServiceClient) GetFrameRange(ctx context.Context, in *GetFrameRangeRequest, opts ...grpc.CallOption) (*FrameSequence, error) {
+	out := new(FrameSequence)
+	err := c.cc.Invoke(ctx, "/masterclass.go.FrameService/GetFrameRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FrameServiceServer is the server API for FrameService service.
 // All implementations must embed UnimplementedFrameServiceServer
 // for forward compatibility
 type FrameServiceServer interface {
 	GetFrame(context.Context, *GetFrameRequest) (*Frame, error)
+	StreamFrames(*StreamFramesRequest, FrameService_StreamFramesServer) error
+	UploadFrames(FrameService_UploadFramesServer) error
+	ListFrames(context.Context, *ListFramesRequest) (*ListFramesResponse, error)
+	DeleteFrame(context.Context, *DeleteFrameRequest) (*DeleteFrameResponse, error)
+	WatchFrames(FrameService_WatchFramesServer) error
+	BatchGetFrames(context.Context, *BatchGetFramesRequest) (*BatchGetFramesResponse, error)
+	ConvertFrame(context.Context, *ConvertFrameRequest) (*Frame, error)
+	PutFrame(context.Context, *PutFrameRequest) (*PutFrameResponse, error)
+	GetFrameRange(context.Context, *GetFrameRangeRequest) (*FrameSequence, error)
 	mustEmbedUnimplementedFrameServiceServer()
 }
 
@@ -57,6 +226,33 @@ type UnimplementedFrameServiceServer struct {
 func (UnimplementedFrameServiceServer) GetFrame(context.Context, *GetFrameRequest) (*Frame, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetFrame not implemented")
 }
+func (UnimplementedFrameServiceServer) StreamFrames(*StreamFramesRequest, FrameService_StreamFramesServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamFrames not implemented")
+}
+func (UnimplementedFrameServiceServer) UploadFrames(FrameService_UploadFramesServer) error {
+	return status.Errorf(codes.Unimplemented, "method UploadFrames not implemented")
+}
+func (UnimplementedFrameServiceServer) ListFrames(context.Context, *ListFramesRequest) (*ListFramesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFrames not implemented")
+}
+func (UnimplementedFrameServiceServer) DeleteFrame(context.Context, *DeleteFrameRequest) (*DeleteFrameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFrame not implemented")
+}
+func (UnimplementedFrameServiceServer) WatchFrames(FrameService_WatchFramesServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchFrames not implemented")
+}
+func (UnimplementedFrameServiceServer) BatchGetFrames(context.Context, *BatchGetFramesRequest) (*BatchGetFramesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetFrames not implemented")
+}
+func (UnimplementedFrameServiceServer) ConvertFrame(context.Context, *ConvertFrameRequest) (*Frame, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertFrame not implemented")
+}
+func (UnimplementedFrameServiceServer) PutFrame(context.Context, *PutFrameRequest) (*PutFrameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutFrame not implemented")
+}
+func (UnimplementedFrameServiceServer) GetFrameRange(context.Context, *GetFrameRangeRequest) (*FrameSequence, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFrameRange not implemented")
+}
 func (UnimplementedFrameServiceServer) mustEmbedUnimplementedFrameServiceServer() {}
 
 // UnsafeFrameServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -88,6 +284,187 @@ func _FrameService_GetFrame_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FrameService_StreamFrames_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamFramesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FrameServiceServer).StreamFrames(m, &frameServiceStreamFramesServer{stream})
+}
+
+type FrameService_StreamFramesServer interface {
+	Send(*Frame) error
+	grpc.ServerStream
+}
+
+type frameServiceStreamFramesServer struct {
+	grpc.ServerStream
+}
+
+func (x *frameServiceStreamFramesServer) Send(m *Frame) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _FrameService_UploadFrames_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(FrameServiceServer).UploadFrames(&frameServiceUploadFramesServer{stream})
+}
+
+type FrameService_UploadFramesServer interface {
+	SendAndClose(*UploadFramesResponse) error
+	Recv() (*Frame, error)
+	grpc.ServerStream
+}
+
+type frameServiceUploadFramesServer struct {
+	grpc.ServerStream
+}
+
+func (x *frameServiceUploadFramesServer) SendAndClose(m *UploadFramesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *frameServiceUploadFramesServer) Recv() (*Frame, error) {
+	m := new(Frame)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _FrameService_ListFrames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFramesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrameServiceServer).ListFrames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/masterclass.go.FrameService/ListFrames",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrameServiceServer).ListFrames(ctx, req.(*ListFramesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FrameService_DeleteFrame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFrameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrameServiceServer).DeleteFrame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/masterclass.go.FrameService/DeleteFrame",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrameServiceServer).DeleteFrame(ctx, req.(*DeleteFrameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FrameService_WatchFrames_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(FrameServiceServer).WatchFrames(&frameServiceWatchFramesServer{stream})
+}
+
+type FrameService_WatchFramesServer interface {
+	Send(*Frame) error
+	Recv() (*WatchRequest, error)
+	grpc.ServerStream
+}
+
+type frameServiceWatchFramesServer struct {
+	grpc.ServerStream
+}
+
+func (x *frameServiceWatchFramesServer) Send(m *Frame) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *frameServiceWatchFramesServer) Recv() (*WatchRequest, error) {
+	m := new(WatchRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _FrameService_BatchGetFrames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetFramesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrameServiceServer).BatchGetFrames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/masterclass.go.FrameService/BatchGetFrames",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrameServiceServer).BatchGetFrames(ctx, req.(*BatchGetFramesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FrameService_ConvertFrame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConvertFrameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrameServiceServer).ConvertFrame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/masterclass.go.FrameService/ConvertFrame",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrameServiceServer).ConvertFrame(ctx, req.(*ConvertFrameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FrameService_PutFrame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutFrameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrameServiceServer).PutFrame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/masterclass.go.FrameService/PutFrame",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrameServiceServer).PutFrame(ctx, req.(*PutFrameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FrameService_GetFrameRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFrameRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrameServiceServer).GetFrameRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/masterclass.go.FrameService/GetFrameRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrameServiceServer).GetFrameRange(ctx, req.(*GetFrameRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // FrameService_ServiceDesc is the grpc.ServiceDesc for FrameService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -99,7 +476,48 @@ var FrameService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetFrame",
 			Handler:    _FrameService_GetFrame_Handler,
 		},
+		{
+			MethodName: "ListFrames",
+			Handler:    _FrameService_ListFrames_Handler,
+		},
+		{
+			MethodName: "DeleteFrame",
+			Handler:    _FrameService_DeleteFrame_Handler,
+		},
+		{
+			MethodName: "BatchGetFrames",
+			Handler:    _FrameService_BatchGetFrames_Handler,
+		},
+		{
+			MethodName: "ConvertFrame",
+			Handler:    _FrameService_ConvertFrame_Handler,
+		},
+		{
+			MethodName: "PutFrame",
+			Handler:    _FrameService_PutFrame_Handler,
+		},
+		{
+			MethodName: "GetFrameRange",
+			Handler:    _FrameService_GetFrameRange_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamFrames",
+			Handler:       _FrameService_StreamFrames_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "UploadFrames",
+			Handler:       _FrameService_UploadFrames_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "WatchFrames",
+			Handler:       _FrameService_WatchFrames_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "protos/example.proto",
 }
@@ -0,0 +1,79 @@
+package pb
+
+import "fmt"
+
+const maxThumbnailDimension = 8192
+
+func (x *GetFrameRequest) Validate() error {
+	if x.GetId() <= 0 {
+		return fmt.Errorf("invalid frame id %d", x.GetId())
+	}
+
+	if x.GetMaxWidth() > maxThumbnailDimension || x.GetMaxHeight() > maxThumbnailDimension {
+		return fmt.Errorf("thumbnail bounds %dx%d exceed %d", x.GetMaxWidth(), x.GetMaxHeight(), maxThumbnailDimension)
+	}
+
+	return nil
+}
+
+func (x *DeleteFrameRequest) Validate() error {
+	if x.GetId() <= 0 {
+		return fmt.Errorf("invalid frame id %d", x.GetId())
+	}
+
+	return nil
+}
+
+func (x *BatchGetFramesRequest) Validate() error {
+	for _, id := range x.GetIds() {
+		if id <= 0 {
+			return fmt.Errorf("invalid frame id %d", id)
+		}
+	}
+
+	return nil
+}
+
+func (x *ConvertFrameRequest) Validate() error {
+	if x.GetId() <= 0 {
+		return fmt.Errorf("invalid frame id %d", x.GetId())
+	}
+
+	if x.GetEncoding() == "" {
+		return fmt.Errorf("missing target encoding")
+	}
+
+	return nil
+}
+
+func (x *GetFrameRangeRequest) Validate() error {
+	if x.GetStartId() <= 0 {
+		return fmt.Errorf("invalid start id %d", x.GetStartId())
+	}
+
+	if x.GetCount() <= 0 {
+		return fmt.Errorf("count must be positive, got %d", x.GetCount())
+	}
+
+	return nil
+}
+
+func (x *PutFrameRequest) Validate() error {
+	if x.GetFrame() == nil {
+		return fmt.Errorf("missing frame")
+	}
+
+	return x.GetFrame().Validate()
+}
+
+func (x *Frame) Validate() error {
+	if x.GetId() <= 0 {
+		return fmt.Errorf("invalid frame id %d", x.GetId())
+	}
+
+	if len(x.GetData()) == 0 && (x.GetWidth() != 0 || x.GetHeight() != 0) {
+		return fmt.Errorf("frame %d has dimensions %dx%d but no data", x.GetId(), x.GetWidth(), x.GetHeight())
+	}
+
+	return nil
+}
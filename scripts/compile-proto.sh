@@ -1,3 +1,5 @@
-protoc --go_out=./pkg/pb --go_opt=module=github.com/uandersonricardo/masterclass-go/pkg/pb \
+protoc -I . -I ./protos \
+       --go_out=./pkg/pb --go_opt=module=github.com/uandersonricardo/masterclass-go/pkg/pb \
        --go-grpc_out=./pkg/pb --go-grpc_opt=module=github.com/uandersonricardo/masterclass-go/pkg/pb \
-       ./protos/example.proto
+       --grpc-gateway_out=./pkg/pb --grpc-gateway_opt=module=github.com/uandersonricardo/masterclass-go/pkg/pb \
+       ./protos/example.proto
\ No newline at end of file